@@ -1,75 +1,165 @@
 package ante
 
-// import (
-// 	sdk "github.com/cosmos/cosmos-sdk/types"
-// 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-// 	tmstrings "github.com/tendermint/tendermint/libs/strings"
-// )
-
-// const maxBypassMinFeeMsgGasUsage = uint64(200_000)
-
-// // MempoolFeeDecorator will check if the transaction's fee is at least as large
-// // as the local validator's minimum gasFee (defined in validator config).
-// //
-// // If fee is too low, decorator returns error and tx is rejected from mempool.
-// // Note this only applies when ctx.CheckTx = true. If fee is high enough or not
-// // CheckTx, then call next AnteHandler.
-// //
-// // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
-// type MempoolFeeDecorator struct {
-// 	BypassMinFeeMsgTypes []string
-// }
-
-// func NewMempoolFeeDecorator(bypassMsgTypes []string) MempoolFeeDecorator {
-// 	return MempoolFeeDecorator{
-// 		BypassMinFeeMsgTypes: bypassMsgTypes,
-// 	}
-// }
-
-// func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-// 	feeTx, ok := tx.(sdk.FeeTx)
-// 	if !ok {
-// 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
-// 	}
-
-// 	feeCoins := feeTx.GetFee()
-// 	gas := feeTx.GetGas()
-// 	msgs := feeTx.GetMsgs()
-
-// 	// Only check for minimum fees if the execution mode is CheckTx and the tx does
-// 	// not contain operator configured bypass messages. If the tx does contain
-// 	// operator configured bypass messages only, it's total gas must be less than
-// 	// or equal to a constant, otherwise minimum fees are checked to prevent spam.
-// 	if ctx.IsCheckTx() && !simulate && !(mfd.bypassMinFeeMsgs(msgs) && gas <= uint64(len(msgs))*maxBypassMinFeeMsgGasUsage) {
-// 		minGasPrices := ctx.MinGasPrices()
-// 		if !minGasPrices.IsZero() {
-// 			requiredFees := make(sdk.Coins, len(minGasPrices))
-
-// 			// Determine the required fees by multiplying each required minimum gas
-// 			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-// 			glDec := sdk.NewDec(int64(gas))
-// 			for i, gp := range minGasPrices {
-// 				fee := gp.Amount.Mul(glDec)
-// 				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
-// 			}
-
-// 			if !feeCoins.IsAnyGTE(requiredFees) {
-// 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
-// 			}
-// 		}
-// 	}
-
-// 	return next(ctx, tx, simulate)
-// }
-
-// func (mfd MempoolFeeDecorator) bypassMinFeeMsgs(msgs []sdk.Msg) bool {
-// 	for _, msg := range msgs {
-// 		if tmstrings.StringInSlice(sdk.MsgTypeURL(msg), mfd.BypassMinFeeMsgTypes) {
-// 			continue
-// 		}
-
-// 		return false
-// 	}
-
-// 	return true
-// }
+import (
+	"context"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
+
+	gaiatelemetry "github.com/cosmos/gaia/v8/telemetry"
+)
+
+// MempoolFeeChecker will check if the transaction's fee is at least as large
+// as the local validator's minimum gasFee (defined in validator config).
+//
+// If fee is too low, the checker returns an error and the tx is rejected from
+// the mempool. Note this only applies when ctx.CheckTx = true. Apart from the
+// telemetry it records, it checks the fees as the SDK default fee checker.
+//
+// Fees paid in the non-native denoms accepted by the fee converter are
+// checked at their value in the native denom.
+//
+// CONTRACT: Tx must implement FeeTx to use MempoolFeeChecker
+type MempoolFeeChecker struct {
+	FeeConverter FeeConverter
+}
+
+// FeeConverter converts the fees paid in non-native denoms to the native
+// denom, returning the fees paid in other denoms as is.
+type FeeConverter interface {
+	ConvertFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins
+}
+
+// FeeEscrower escrows the fees paid in non-native denoms once they are
+// deducted to the fee collector.
+type FeeEscrower interface {
+	EscrowFees(ctx sdk.Context, fees sdk.Coins) error
+}
+
+func NewMempoolFeeChecker(feeConverter FeeConverter) MempoolFeeChecker {
+	return MempoolFeeChecker{
+		FeeConverter: feeConverter,
+	}
+}
+
+var _ authmiddleware.TxFeeChecker = MempoolFeeChecker{}.CheckTxFee
+
+// CheckTxFee implements the authmiddleware.TxFeeChecker function type. It
+// returns the fee to be deducted and the priority of the transaction.
+func (mfc MempoolFeeChecker) CheckTxFee(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		gaiatelemetry.IncrFeeRejection(ctx, gaiatelemetry.FeeRejectionInvalidTx)
+		return nil, 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	// Only check for minimum fees if the execution mode is CheckTx.
+	if !ctx.IsCheckTx() {
+		return feeCoins, getTxPriority(feeCoins), nil
+	}
+
+	minGasPrices := ctx.MinGasPrices()
+	if !minGasPrices.IsZero() {
+		requiredFees := make(sdk.Coins, len(minGasPrices))
+
+		// Determine the required fees by multiplying each required minimum gas
+		// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+		glDec := sdk.NewDec(int64(gas))
+		for i, gp := range minGasPrices {
+			fee := gp.Amount.Mul(glDec)
+			requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+		}
+
+		if !feeCoins.IsAnyGTE(requiredFees) {
+			if mfc.FeeConverter != nil {
+				if convertedFees := mfc.FeeConverter.ConvertFees(ctx, feeCoins); convertedFees.IsAnyGTE(requiredFees) {
+					return feeCoins, getTxPriority(convertedFees), nil
+				}
+			}
+
+			gaiatelemetry.IncrFeeRejection(ctx, gaiatelemetry.FeeRejectionInsufficientFee)
+
+			return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+		}
+	}
+
+	return feeCoins, getTxPriority(feeCoins), nil
+}
+
+// getTxPriority returns a naive tx priority based on the amount of the smallest
+// denomination of the fee provided in a transaction, mirroring the SDK default.
+func getTxPriority(fee sdk.Coins) int64 {
+	var priority int64
+	for _, c := range fee {
+		p := int64(math.MaxInt64)
+		if c.Amount.IsInt64() {
+			p = c.Amount.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
+
+// EscrowFeeMiddleware escrows the non-native fees of the transactions. It
+// must run right after the DeductFeeMiddleware, and the fee checker must
+// deduct the fees as they are paid, as MempoolFeeChecker does.
+func EscrowFeeMiddleware(fe FeeEscrower) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return escrowFeeTxHandler{
+			feeEscrower: fe,
+			next:        txh,
+		}
+	}
+}
+
+type escrowFeeTxHandler struct {
+	feeEscrower FeeEscrower
+	next        tx.Handler
+}
+
+var _ tx.Handler = escrowFeeTxHandler{}
+
+func (efh escrowFeeTxHandler) escrowFees(ctx context.Context, sdkTx sdk.Tx) error {
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	return efh.feeEscrower.EscrowFees(sdk.UnwrapSDKContext(ctx), feeTx.GetFee())
+}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (efh escrowFeeTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	if err := efh.escrowFees(ctx, req.Tx); err != nil {
+		return tx.Response{}, tx.ResponseCheckTx{}, err
+	}
+
+	return efh.next.CheckTx(ctx, req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (efh escrowFeeTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := efh.escrowFees(ctx, req.Tx); err != nil {
+		return tx.Response{}, err
+	}
+
+	return efh.next.DeliverTx(ctx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (efh escrowFeeTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := efh.escrowFees(ctx, req.Tx); err != nil {
+		return tx.Response{}, err
+	}
+
+	return efh.next.SimulateTx(ctx, req)
+}
@@ -1,59 +1,201 @@
 package ante_test
 
-// import (
-// 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
-// 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
-// 	sdk "github.com/cosmos/cosmos-sdk/types"
-// 	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
-// 	ibcchanneltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
-
-// 	"github.com/cosmos/gaia/v8/ante"
-// )
-
-// func (s *IntegrationTestSuite) TestMempoolFeeDecorator() {
-// 	s.SetupTest()
-// 	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
-
-// 	mfd := ante.NewMempoolFeeDecorator([]string{
-// 		sdk.MsgTypeURL(&ibcchanneltypes.MsgRecvPacket{}),
-// 		sdk.MsgTypeURL(&ibcchanneltypes.MsgAcknowledgement{}),
-// 		sdk.MsgTypeURL(&ibcclienttypes.MsgUpdateClient{}),
-// 	})
-// 	antehandler := sdk.ChainAnteDecorators(mfd)
-// 	priv1, _, addr1 := testdata.KeyTestPubAddr()
-
-// 	msg := testdata.NewTestMsg(addr1)
-// 	feeAmount := testdata.NewTestFeeAmount()
-// 	gasLimit := testdata.NewTestGasLimit()
-// 	s.Require().NoError(s.txBuilder.SetMsgs(msg))
-// 	s.txBuilder.SetFeeAmount(feeAmount)
-// 	s.txBuilder.SetGasLimit(gasLimit)
-
-// 	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
-// 	tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
-// 	s.Require().NoError(err)
-
-// 	// Set high gas price so standard test fee fails
-// 	feeAmt := sdk.NewDecCoinFromDec("uatom", sdk.NewDec(200).Quo(sdk.NewDec(100000)))
-// 	minGasPrice := []sdk.DecCoin{feeAmt}
-// 	s.ctx = s.ctx.WithMinGasPrices(minGasPrice).WithIsCheckTx(true)
-
-// 	// antehandler errors with insufficient fees
-// 	_, err = antehandler(s.ctx, tx, false)
-// 	s.Require().Error(err, "expected error due to low fee")
-
-// 	// ensure no fees for certain IBC msgs
-// 	s.Require().NoError(s.txBuilder.SetMsgs(
-// 		ibcchanneltypes.NewMsgRecvPacket(ibcchanneltypes.Packet{}, nil, ibcclienttypes.Height{}, ""),
-// 	))
-
-// 	oracleTx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
-// 	_, err = antehandler(s.ctx, oracleTx, false)
-// 	s.Require().NoError(err, "expected min fee bypass for IBC messages")
-
-// 	s.ctx = s.ctx.WithIsCheckTx(false)
-
-// 	// antehandler should not error since we do not check min gas prices in DeliverTx
-// 	_, err = antehandler(s.ctx, tx, false)
-// 	s.Require().NoError(err, "unexpected error during DeliverTx")
-// }
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	liquiditytypes "github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+
+	"github.com/cosmos/gaia/v8/ante"
+	gaiaapp "github.com/cosmos/gaia/v8/app"
+	gaiahelpers "github.com/cosmos/gaia/v8/app/helpers"
+	feeabstypes "github.com/cosmos/gaia/v8/x/feeabs/types"
+)
+
+func TestMempoolFeeChecker(t *testing.T) {
+	encodingConfig := gaiaapp.MakeEncodingConfig()
+	_, _, addr1 := testdata.KeyTestPubAddr()
+
+	mfc := ante.NewMempoolFeeChecker(nil)
+
+	newTx := func(msg sdk.Msg, gasLimit uint64) sdk.Tx {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		txBuilder.SetGasLimit(gasLimit)
+		return txBuilder.GetTx()
+	}
+
+	// Set high gas price so standard test fee fails
+	feeAmt := sdk.NewDecCoinFromDec("atom", sdk.NewDec(200).Quo(sdk.NewDec(100000)))
+	ctx := sdk.NewContext(nil, tmproto.Header{ChainID: "test-chain"}, true, log.NewNopLogger()).
+		WithMinGasPrices(sdk.DecCoins{feeAmt})
+
+	sendTx := newTx(banktypes.NewMsgSend(addr1, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))), testdata.NewTestGasLimit())
+
+	// checker errors with insufficient fees
+	_, _, err := mfc.CheckTxFee(ctx, sendTx)
+	require.Error(t, err, "expected error due to low fee")
+
+	// IBC messages are subject to min fees as well
+	recvTx := newTx(ibcchanneltypes.NewMsgRecvPacket(ibcchanneltypes.Packet{}, nil, ibcclienttypes.Height{}, addr1.String()), testdata.NewTestGasLimit())
+	_, _, err = mfc.CheckTxFee(ctx, recvTx)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// checker should not error since we do not check min gas prices in DeliverTx
+	_, _, err = mfc.CheckTxFee(ctx.WithIsCheckTx(false), sendTx)
+	require.NoError(t, err, "unexpected error during DeliverTx")
+}
+
+// fixedFeeConverter converts the fees paid in its denoms to atom at fixed
+// prices.
+type fixedFeeConverter map[string]sdk.Dec
+
+func (c fixedFeeConverter) ConvertFees(_ sdk.Context, fees sdk.Coins) sdk.Coins {
+	converted := sdk.NewCoins()
+	for _, fee := range fees {
+		if price, ok := c[fee.Denom]; ok {
+			converted = converted.Add(sdk.NewCoin("atom", price.MulInt(fee.Amount).TruncateInt()))
+			continue
+		}
+		converted = converted.Add(fee)
+	}
+
+	return converted
+}
+
+func TestMempoolFeeCheckerConvertedFees(t *testing.T) {
+	encodingConfig := gaiaapp.MakeEncodingConfig()
+	_, _, addr1 := testdata.KeyTestPubAddr()
+
+	mfc := ante.NewMempoolFeeChecker(fixedFeeConverter{"ibc/cheap": sdk.NewDecWithPrec(1, 1), "ibc/dear": sdk.NewDec(2)})
+
+	newTx := func(fee sdk.Coins) sdk.Tx {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr1, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(100_000)
+		return txBuilder.GetTx()
+	}
+
+	// 100 000 gas at 0.002atom require 200atom
+	ctx := sdk.NewContext(nil, tmproto.Header{ChainID: "test-chain"}, true, log.NewNopLogger()).
+		WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(2, 3))})
+
+	// the fees are deducted in the denoms they are paid in
+	fee := sdk.NewCoins(sdk.NewInt64Coin("ibc/dear", 100))
+	deducted, priority, err := mfc.CheckTxFee(ctx, newTx(fee))
+	require.NoError(t, err)
+	require.Equal(t, fee, deducted)
+	require.Equal(t, int64(200), priority)
+
+	_, _, err = mfc.CheckTxFee(ctx, newTx(sdk.NewCoins(sdk.NewInt64Coin("ibc/cheap", 1000))))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// converted fees add up with the fees paid in atom
+	_, _, err = mfc.CheckTxFee(ctx, newTx(sdk.NewCoins(sdk.NewInt64Coin("ibc/cheap", 1000), sdk.NewInt64Coin("atom", 100))))
+	require.NoError(t, err)
+
+	_, _, err = mfc.CheckTxFee(ctx, newTx(sdk.NewCoins(sdk.NewInt64Coin("ibc/other", 1000))))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
+
+// nextTxHandler stands for the middlewares following the fee escrow.
+type nextTxHandler struct{}
+
+func (nextTxHandler) CheckTx(context.Context, tx.Request, tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	return tx.Response{}, tx.ResponseCheckTx{}, nil
+}
+
+func (nextTxHandler) DeliverTx(context.Context, tx.Request) (tx.Response, error) {
+	return tx.Response{}, nil
+}
+
+func (nextTxHandler) SimulateTx(context.Context, tx.Request) (tx.Response, error) {
+	return tx.Response{}, nil
+}
+
+func TestFeeAbstraction(t *testing.T) {
+	const (
+		feeDenom   = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+		otherDenom = "ibc/0025F8A87464A471E66B234C4F93AEC5B4DA3D42D7986451A059273426290DD5"
+	)
+
+	app := gaiahelpers.Setup(t, false, 0)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)})
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	encodingConfig := gaiaapp.MakeEncodingConfig()
+	_, _, addr1 := testdata.KeyTestPubAddr()
+
+	// pools pricing both ibc denoms at 2 native tokens, of which only the
+	// first one is accepted to pay fees
+	creator := sdk.AccAddress("creator_____________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000_000), sdk.NewInt64Coin(feeDenom, 5_000_000), sdk.NewInt64Coin(otherDenom, 5_000_000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, creator, coins))
+	var pools []liquiditytypes.Pool
+	for _, denom := range []string{feeDenom, otherDenom} {
+		pool, err := app.LiquidityKeeper.CreatePool(ctx, liquiditytypes.NewMsgCreatePool(creator, liquiditytypes.DefaultPoolTypeID,
+			sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10_000_000), sdk.NewInt64Coin(denom, 5_000_000))))
+		require.NoError(t, err)
+		pools = append(pools, pool)
+	}
+	app.FeeAbsKeeper.SetParams(ctx, feeabstypes.NewParams([]feeabstypes.FeeDenom{{Denom: feeDenom, PoolId: pools[0].Id}}, time.Hour, sdk.NewInt(1_000_000)))
+	app.FeeAbsKeeper.RecordPrices(ctx)
+
+	newTx := func(fee sdk.Coins) sdk.Tx {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr1, addr1, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1)))))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(100_000)
+		return txBuilder.GetTx()
+	}
+
+	// 100 000 gas at 0.002 native tokens require 200 native tokens, worth
+	// 100 of either ibc denom
+	mfc := ante.NewMempoolFeeChecker(app.FeeAbsKeeper)
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec(bondDenom, sdk.NewDecWithPrec(2, 3))})
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100), sdk.NewInt64Coin(bondDenom, 10))
+	_, _, err := mfc.CheckTxFee(checkCtx, newTx(fee))
+	require.NoError(t, err)
+
+	// an ibc denom which is not accepted is rejected, even though it is
+	// priced by a pool
+	_, _, err = mfc.CheckTxFee(checkCtx, newTx(sdk.NewCoins(sdk.NewInt64Coin(otherDenom, 100))))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// once deducted, the fees paid in the accepted ibc denom are escrowed
+	// while the native fees are left to the fee collector
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	escrow := app.AccountKeeper.GetModuleAddress(feeabstypes.ModuleName)
+	collected := app.BankKeeper.GetAllBalances(ctx, feeCollector)
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fee))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fee))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = ante.EscrowFeeMiddleware(app.FeeAbsKeeper)(nextTxHandler{}).DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: newTx(fee)})
+	require.NoError(t, err)
+	require.Equal(t, collected.Add(sdk.NewInt64Coin(bondDenom, 10)), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100)), app.BankKeeper.GetAllBalances(ctx, escrow))
+
+	require.Equal(t, sdk.NewEvent(
+		feeabstypes.EventTypeEscrowFee,
+		sdk.NewAttribute(feeabstypes.AttributeKeyFee, sdk.NewInt64Coin(feeDenom, 100).String()),
+		sdk.NewAttribute(feeabstypes.AttributeKeyNativeValue, sdk.NewInt64Coin(bondDenom, 200).String()),
+	), ctx.EventManager().Events()[0])
+}
@@ -0,0 +1,67 @@
+package ante
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
+)
+
+// TxHandlerOptions extend the SDK's tx handler options with the escrow of the
+// fees paid in non-native denoms.
+type TxHandlerOptions struct {
+	authmiddleware.TxHandlerOptions
+
+	FeeEscrower FeeEscrower
+}
+
+// NewTxHandler returns the SDK's default tx handler, escrowing the non-native
+// fees right after they are deducted.
+func NewTxHandler(options TxHandlerOptions) (tx.Handler, error) {
+	if options.TxDecoder == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "txDecoder is required for middlewares")
+	}
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for middlewares")
+	}
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for middlewares")
+	}
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for middlewares")
+	}
+	if options.FeeEscrower == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "fee escrower is required for middlewares")
+	}
+
+	sigGasConsumer := options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = authmiddleware.DefaultSigVerificationGasConsumer
+	}
+
+	// mirrors authmiddleware.NewDefaultTxHandler, see its comments for the
+	// constraints on the order of the middlewares
+	return authmiddleware.ComposeMiddlewares(
+		authmiddleware.NewRunMsgsTxHandler(options.MsgServiceRouter, options.LegacyRouter),
+		authmiddleware.NewTxDecoderMiddleware(options.TxDecoder),
+		authmiddleware.GasTxMiddleware,
+		authmiddleware.RecoveryTxMiddleware,
+		authmiddleware.NewIndexEventsTxMiddleware(options.IndexEvents),
+		authmiddleware.NewExtensionOptionsMiddleware(options.ExtensionOptionChecker),
+		authmiddleware.ValidateBasicMiddleware,
+		authmiddleware.TxTimeoutHeightMiddleware,
+		authmiddleware.ValidateMemoMiddleware(options.AccountKeeper),
+		authmiddleware.ConsumeTxSizeGasMiddleware(options.AccountKeeper),
+		authmiddleware.DeductFeeMiddleware(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		// like the fee deduction, the escrow is not discarded when the tx
+		// fails
+		EscrowFeeMiddleware(options.FeeEscrower),
+		authmiddleware.SetPubKeyMiddleware(options.AccountKeeper),
+		authmiddleware.ValidateSigCountMiddleware(options.AccountKeeper),
+		authmiddleware.SigGasConsumeMiddleware(options.AccountKeeper, sigGasConsumer),
+		authmiddleware.SigVerificationMiddleware(options.AccountKeeper, options.SignModeHandler),
+		authmiddleware.IncrementSequenceMiddleware(options.AccountKeeper),
+		authmiddleware.WithBranchedStore,
+		authmiddleware.ConsumeBlockGasMiddleware,
+		authmiddleware.NewTipMiddleware(options.BankKeeper),
+	), nil
+}
@@ -1,6 +1,7 @@
 package gaia
 
 import (
+	"context"
 	"fmt"
 	"io"
 	stdlog "log"
@@ -8,8 +9,6 @@ import (
 	"os"
 	"path/filepath"
 
-	"github.com/cosmos/cosmos-sdk/testutil/testdata_pulsar"
-
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
@@ -20,7 +19,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
-	"github.com/cosmos/cosmos-sdk/store/streaming"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -29,8 +27,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
-	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
-	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
@@ -65,7 +61,6 @@ import (
 	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
-	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -123,11 +118,49 @@ import (
 	// routerkeeper "github.com/strangelove-ventures/packet-forward-middleware/v2/router/keeper"
 	// routertypes "github.com/strangelove-ventures/packet-forward-middleware/v2/router/types"
 
-	// gaiaante "github.com/cosmos/gaia/v8/ante"
+	gaiaante "github.com/cosmos/gaia/v8/ante"
 	gaiaappparams "github.com/cosmos/gaia/v8/app/params"
+	gaiastreaming "github.com/cosmos/gaia/v8/streaming"
+	gaiatelemetry "github.com/cosmos/gaia/v8/telemetry"
+	aprkeeper "github.com/cosmos/gaia/v8/x/apr/keeper"
+	aprtypes "github.com/cosmos/gaia/v8/x/apr/types"
+	"github.com/cosmos/gaia/v8/x/autocompound"
+	autocompoundkeeper "github.com/cosmos/gaia/v8/x/autocompound/keeper"
+	autocompoundtypes "github.com/cosmos/gaia/v8/x/autocompound/types"
+	"github.com/cosmos/gaia/v8/x/denomfilter"
+	denomfilterkeeper "github.com/cosmos/gaia/v8/x/denomfilter/keeper"
+	denomfiltertypes "github.com/cosmos/gaia/v8/x/denomfilter/types"
+	"github.com/cosmos/gaia/v8/x/feeabs"
+	feeabsclient "github.com/cosmos/gaia/v8/x/feeabs/client"
+	feeabskeeper "github.com/cosmos/gaia/v8/x/feeabs/keeper"
+	feeabstypes "github.com/cosmos/gaia/v8/x/feeabs/types"
+	ibchealthkeeper "github.com/cosmos/gaia/v8/x/ibchealth/keeper"
+	ibchealthtypes "github.com/cosmos/gaia/v8/x/ibchealth/types"
+	"github.com/cosmos/gaia/v8/x/ibchooks"
+	ibchookskeeper "github.com/cosmos/gaia/v8/x/ibchooks/keeper"
+	ibchookstypes "github.com/cosmos/gaia/v8/x/ibchooks/types"
+	"github.com/cosmos/gaia/v8/x/liquidstaking"
+	liquidstakingkeeper "github.com/cosmos/gaia/v8/x/liquidstaking/keeper"
+	liquidstakingtypes "github.com/cosmos/gaia/v8/x/liquidstaking/types"
+	portfoliokeeper "github.com/cosmos/gaia/v8/x/portfolio/keeper"
+	portfoliotypes "github.com/cosmos/gaia/v8/x/portfolio/types"
+	"github.com/cosmos/gaia/v8/x/ratelimit"
+	ratelimitkeeper "github.com/cosmos/gaia/v8/x/ratelimit/keeper"
+	ratelimittypes "github.com/cosmos/gaia/v8/x/ratelimit/types"
+	"github.com/cosmos/gaia/v8/x/recovery"
+	recoveryclient "github.com/cosmos/gaia/v8/x/recovery/client"
+	recoverykeeper "github.com/cosmos/gaia/v8/x/recovery/keeper"
+	recoverytypes "github.com/cosmos/gaia/v8/x/recovery/types"
+	swapindexer "github.com/cosmos/gaia/v8/x/swap/indexer"
+	swapkeeper "github.com/cosmos/gaia/v8/x/swap/keeper"
+	swaptypes "github.com/cosmos/gaia/v8/x/swap/types"
+	txhistoryindexer "github.com/cosmos/gaia/v8/x/txhistory/indexer"
+	txhistorytypes "github.com/cosmos/gaia/v8/x/txhistory/types"
 
 	// unnamed import of statik for swagger UI support
 	_ "github.com/cosmos/cosmos-sdk/client/docs/statik"
+
+	gaiadocs "github.com/cosmos/gaia/v8/client/docs"
 )
 
 var (
@@ -153,6 +186,8 @@ var (
 				upgradeclient.LegacyCancelProposalHandler,
 				ibcclientclient.UpdateClientProposalHandler,
 				ibcclientclient.UpgradeProposalHandler,
+				recoveryclient.RecoverEscrowProposalHandler,
+				feeabsclient.ReleaseEscrowedFeesProposalHandler,
 			},
 		),
 		params.AppModuleBasic{},
@@ -169,6 +204,13 @@ var (
 		liquidity.AppModuleBasic{},
 		// router.AppModuleBasic{},
 		ica.AppModuleBasic{},
+		autocompound.AppModuleBasic{},
+		liquidstaking.AppModuleBasic{},
+		feeabs.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
+		denomfilter.AppModuleBasic{},
+		ibchooks.AppModuleBasic{},
+		recovery.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -182,6 +224,8 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		liquiditytypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		liquidstakingtypes.ModuleName:  {authtypes.Minter, authtypes.Burner},
+		feeabstypes.ModuleName:         nil,
 	}
 )
 
@@ -230,6 +274,14 @@ type GaiaApp struct { // nolint: golint
 	AuthzKeeper     authzkeeper.Keeper
 	LiquidityKeeper liquiditykeeper.Keeper
 
+	AutoCompoundKeeper  autocompoundkeeper.Keeper
+	LiquidStakingKeeper liquidstakingkeeper.Keeper
+	FeeAbsKeeper        feeabskeeper.Keeper
+	RateLimitKeeper     ratelimitkeeper.Keeper
+	DenomFilterKeeper   denomfilterkeeper.Keeper
+	IBCHooksKeeper      ibchookskeeper.Keeper
+	RecoveryKeeper      recoverykeeper.Keeper
+
 	// RouterKeeper    routerkeeper.Keeper
 
 	// make scoped keepers public for test purposes
@@ -243,6 +295,17 @@ type GaiaApp struct { // nolint: golint
 	// simulation manager
 	sm           *module.SimulationManager
 	configurator module.Configurator
+
+	// services registered by the modules
+	msgServices   *serviceRecorder
+	queryServices *serviceRecorder
+
+	// node-local watchdog of the IBC light clients
+	ibcHealthWatchdog *ibchealthkeeper.Watchdog
+
+	// whether the node records telemetry, in which case the Gaia metrics
+	// which are costly to compute are recorded
+	telemetryEnabled bool
 }
 
 func init() {
@@ -276,21 +339,9 @@ func NewGaiaApp(
 	bApp.SetVersion(version.Version)
 	bApp.SetInterfaceRegistry(interfaceRegistry)
 
-	keys := sdk.NewKVStoreKeys(
-		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
-		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
-		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
-		evidencetypes.StoreKey, liquiditytypes.StoreKey, ibctransfertypes.StoreKey,
-		capabilitytypes.StoreKey, feegrant.StoreKey, authzkeeper.StoreKey /*routertypes.StoreKey,*/, icahosttypes.StoreKey, group.StoreKey,
-	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
-	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
-
-	// configure state listening capabilities using AppOptions
-	// we are doing nothing with the returned streamingServices and waitGroup in this case
-	if _, _, err := streaming.LoadStreamingServices(bApp, appOpts, appCodec, keys); err != nil {
-		tmos.Exit(err.Error())
-	}
+	keys := sdk.NewKVStoreKeys(appModules.kvStoreKeys()...)
+	tkeys := sdk.NewTransientStoreKeys(appModules.transientStoreKeys()...)
+	memKeys := sdk.NewMemoryStoreKeys(appModules.memStoreKeys()...)
 
 	app := &GaiaApp{
 		BaseApp:           bApp,
@@ -411,11 +462,43 @@ func NewGaiaApp(
 		app.AccountKeeper,
 		app.DistrKeeper,
 	)
+	app.LiquidStakingKeeper = liquidstakingkeeper.NewKeeper(
+		appCodec,
+		keys[liquidstakingtypes.StoreKey],
+		app.GetSubspace(liquidstakingtypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		&stakingKeeper,
+	)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper = *stakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
+		stakingtypes.NewMultiStakingHooks(
+			app.DistrKeeper.Hooks(),
+			app.SlashingKeeper.Hooks(),
+			app.LiquidStakingKeeper.Hooks(),
+		),
+	)
+
+	app.AutoCompoundKeeper = autocompoundkeeper.NewKeeper(
+		appCodec,
+		keys[autocompoundtypes.StoreKey],
+		app.GetSubspace(autocompoundtypes.ModuleName),
+		app.AuthzKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		app.StakingKeeper,
+	)
+	app.FeeAbsKeeper = feeabskeeper.NewKeeper(
+		appCodec,
+		keys[feeabstypes.StoreKey],
+		app.GetSubspace(feeabstypes.ModuleName),
+		app.BankKeeper,
+		app.DistrKeeper,
+		app.LiquidityKeeper,
+		app.StakingKeeper,
 	)
 
 	// set the governance module account as the authority for conducting upgrades
@@ -439,6 +522,17 @@ func NewGaiaApp(
 		scopedIBCKeeper,
 	)
 
+	// the recovery keeper records the in-flight transfers whose escrow can be
+	// returned by governance, so it is created along with the proposal routes
+	app.RecoveryKeeper = recoverykeeper.NewKeeper(
+		appCodec,
+		keys[recoverytypes.StoreKey],
+		app.BankKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
+		app.IBCKeeper.ChannelKeeper,
+	)
+
 	// register the proposal types
 
 	govRouter := govv1beta1.NewRouter()
@@ -446,7 +540,9 @@ func NewGaiaApp(
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(recoverytypes.RouterKey, recovery.NewRecoverEscrowProposalHandler(app.RecoveryKeeper)).
+		AddRoute(feeabstypes.RouterKey, feeabs.NewReleaseEscrowedFeesProposalHandler(app.FeeAbsKeeper))
 	govConfig := govtypes.DefaultConfig()
 	/*
 		Example of setting gov params:
@@ -473,11 +569,27 @@ func NewGaiaApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// the transfers are sent through the denom filter keeper, which checks
+	// their denoms, then through the rate limit keeper, which checks their
+	// outflows, then through the recovery keeper, which records them, before
+	// passing them to the channel keeper
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		appCodec,
+		keys[ratelimittypes.StoreKey],
+		app.GetSubspace(ratelimittypes.ModuleName),
+		app.BankKeeper,
+		app.RecoveryKeeper,
+	)
+	app.DenomFilterKeeper = denomfilterkeeper.NewKeeper(
+		app.GetSubspace(denomfiltertypes.ModuleName),
+		app.RateLimitKeeper,
+	)
+
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
 		keys[ibctransfertypes.StoreKey],
 		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper,
+		app.DenomFilterKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
@@ -486,7 +598,25 @@ func NewGaiaApp(
 	)
 
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
-	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
+	app.IBCHooksKeeper = ibchookskeeper.NewKeeper(
+		appCodec,
+		keys[ibchookstypes.StoreKey],
+		app.GetSubspace(ibchookstypes.ModuleName),
+		app.msgSvcRouter,
+	)
+	// the hooks middleware strips the memos the other middlewares and the
+	// transfer module cannot decode, and executes the hooks once the
+	// transfers are received
+	transferIBCModule := ibchooks.NewIBCMiddleware(
+		denomfilter.NewIBCMiddleware(
+			ratelimit.NewIBCMiddleware(
+				recovery.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), app.RecoveryKeeper),
+				app.RateLimitKeeper,
+			),
+			app.DenomFilterKeeper,
+		),
+		app.IBCHooksKeeper,
+	)
 
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec, keys[icahosttypes.StoreKey],
@@ -498,7 +628,7 @@ func NewGaiaApp(
 		app.msgSvcRouter,
 	)
 	icaModule := ica.NewAppModule(nil, &app.ICAHostKeeper)
-	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
+	icaHostIBCModule := gaiatelemetry.NewICAHostMiddleware(icahost.NewIBCModule(app.ICAHostKeeper), appCodec)
 
 	// app.RouterKeeper = routerkeeper.NewKeeper(appCodec, keys[routertypes.StoreKey], app.GetSubspace(routertypes.ModuleName), app.TransferKeeper, app.DistrKeeper)
 
@@ -552,93 +682,31 @@ func NewGaiaApp(
 		transferModule,
 		icaModule,
 		// routerModule,
+		autocompound.NewAppModule(app.AutoCompoundKeeper),
+		liquidstaking.NewAppModule(app.LiquidStakingKeeper),
+		feeabs.NewAppModule(app.FeeAbsKeeper),
+		ratelimit.NewAppModule(app.RateLimitKeeper),
+		denomfilter.NewAppModule(app.DenomFilterKeeper),
+		ibchooks.NewAppModule(app.IBCHooksKeeper),
+		recovery.NewAppModule(app.RecoveryKeeper),
 	)
 
-	// During begin block slashing happens after distr.BeginBlocker so that
-	// there is nothing left over in the validator fee pool, so as to keep the
-	// CanWithdrawInvariant invariant.
-	// NOTE: staking module is required if HistoricalEntries param > 0
-	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
-	app.mm.SetOrderBeginBlockers(
-		// upgrades should be run first
-		upgradetypes.ModuleName,
-		capabilitytypes.ModuleName,
-		minttypes.ModuleName,
-		distrtypes.ModuleName,
-		slashingtypes.ModuleName,
-		evidencetypes.ModuleName,
-		stakingtypes.ModuleName,
-		authtypes.ModuleName,
-		banktypes.ModuleName,
-		govtypes.ModuleName,
-		crisistypes.ModuleName,
-		liquiditytypes.ModuleName,
-		ibctransfertypes.ModuleName,
-		ibchost.ModuleName,
-		icatypes.ModuleName,
-		// routertypes.ModuleName,
-		genutiltypes.ModuleName,
-		authz.ModuleName,
-		feegrant.ModuleName,
-		group.ModuleName,
-		paramstypes.ModuleName,
-		vestingtypes.ModuleName,
-	)
-	app.mm.SetOrderEndBlockers(
-		crisistypes.ModuleName,
-		govtypes.ModuleName,
-		stakingtypes.ModuleName,
-		liquiditytypes.ModuleName,
-		ibctransfertypes.ModuleName,
-		ibchost.ModuleName,
-		icatypes.ModuleName,
-		// routertypes.ModuleName,
-		capabilitytypes.ModuleName,
-		authtypes.ModuleName,
-		banktypes.ModuleName,
-		distrtypes.ModuleName,
-		slashingtypes.ModuleName,
-		minttypes.ModuleName,
-		genutiltypes.ModuleName,
-		evidencetypes.ModuleName,
-		authz.ModuleName,
-		feegrant.ModuleName,
-		group.ModuleName,
-		paramstypes.ModuleName,
-		upgradetypes.ModuleName,
-		vestingtypes.ModuleName,
-	)
-
-	// NOTE: The genutils module must occur after staking so that pools are
-	// properly initialized with tokens from genesis accounts.
-	// NOTE: The genutils module must also occur after auth so that it can access the params from auth.
-	// NOTE: Capability module must occur first so that it can initialize any capabilities
-	// so that other modules that want to create or claim capabilities afterwards in InitChain
-	// can do so safely.
-	app.mm.SetOrderInitGenesis(
-		capabilitytypes.ModuleName,
-		authtypes.ModuleName,
-		banktypes.ModuleName,
-		distrtypes.ModuleName,
-		stakingtypes.ModuleName,
-		slashingtypes.ModuleName,
-		govtypes.ModuleName,
-		minttypes.ModuleName,
-		crisistypes.ModuleName,
-		genutiltypes.ModuleName,
-		ibctransfertypes.ModuleName,
-		ibchost.ModuleName,
-		icatypes.ModuleName,
-		evidencetypes.ModuleName,
-		liquiditytypes.ModuleName,
-		authz.ModuleName,
-		feegrant.ModuleName,
-		group.ModuleName,
-		// routertypes.ModuleName,
-		paramstypes.ModuleName,
-		upgradetypes.ModuleName,
-		vestingtypes.ModuleName,
-	)
+	if err := appModules.validate(app.mm); err != nil {
+		panic(fmt.Errorf("invalid module registry: %w", err))
+	}
+
+	// the module orders are computed from the ordering constraints declared
+	// in the module registry, see appModules
+	orders, err := appModules.orders()
+	if err != nil {
+		panic(fmt.Errorf("failed to compute module orders: %w", err))
+	}
+	app.mm.SetOrderBeginBlockers(orders.BeginBlockers...)
+	app.mm.SetOrderEndBlockers(orders.EndBlockers...)
+	app.mm.SetOrderInitGenesis(orders.InitGenesis...)
+	if err := appModules.validateOrders(app.mm); err != nil {
+		panic(fmt.Errorf("invalid module orders: %w", err))
+	}
 
 	// Uncomment if you want to set a custom migration order here.
 	// app.mm.SetOrderMigrations(custom order)
@@ -646,34 +714,59 @@ func NewGaiaApp(
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.legacyRouter, app.QueryRouter(), encodingConfig.Amino)
 
-	app.configurator = module.NewConfigurator(app.appCodec, app.msgSvcRouter, app.GRPCQueryRouter())
+	app.msgServices = newServiceRecorder(app.msgSvcRouter)
+	app.queryServices = newServiceRecorder(app.GRPCQueryRouter())
+	app.configurator = module.NewConfigurator(app.appCodec, app.msgServices, app.queryServices)
 	app.mm.RegisterServices(app.configurator)
 
-	// add test gRPC service for testing gRPC queries in isolation
-	testdata_pulsar.RegisterQueryServer(app.GRPCQueryRouter(), testdata_pulsar.QueryImpl{})
+	// configure state listening capabilities using AppOptions, loading the
+	// Gaia streaming services along with the SDK ones
+	// we are doing nothing with the returned streamingServices and waitGroup in this case
+	liquidityIndexer := swapindexer.NewIndexer(app.LiquidityKeeper)
+	txHistoryIndexer := txhistoryindexer.NewIndexer(encodingConfig.TxConfig.TxDecoder())
+	if _, _, err := gaiastreaming.LoadStreamingServices(bApp, appOpts, appCodec, keys, map[string]gaiastreaming.ServiceConstructor{
+		gaiastreaming.JSONLServiceName:  gaiastreaming.NewJSONLStreamingService,
+		gaiastreaming.EventsServiceName: gaiastreaming.NewEventExporter,
+		swapindexer.StreamerName:        liquidityIndexer.Open,
+		txhistoryindexer.StreamerName:   txHistoryIndexer.Open,
+	}); err != nil {
+		tmos.Exit(err.Error())
+	}
+
+	// register the Gaia query services which are not provided by a module
+	swaptypes.RegisterQueryServer(app.queryServices, swapkeeper.NewQuerier(app.LiquidityKeeper))
+	swaptypes.RegisterHistoryServer(app.queryServices, swapindexer.NewQuerier(liquidityIndexer))
+	txhistorytypes.RegisterQueryServer(app.queryServices, txhistoryindexer.NewQuerier(txHistoryIndexer))
+	portfoliotypes.RegisterQueryServer(app.queryServices, portfoliokeeper.NewQuerier(app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.DistrKeeper, app.AuthzKeeper, app.FeeGrantKeeper, app.CommitMultiStore(), keys[banktypes.StoreKey]))
+	aprtypes.RegisterQueryServer(app.queryServices, aprkeeper.NewQuerier(app.MintKeeper, app.DistrKeeper, app.StakingKeeper))
+	ibcHealthQuerier := ibchealthkeeper.NewQuerier(appCodec, app.IBCKeeper.ClientKeeper, app.IBCKeeper.ConnectionKeeper, app.IBCKeeper.ChannelKeeper)
+	ibchealthtypes.RegisterQueryServer(app.queryServices, ibcHealthQuerier)
+
+	// the IBC client expiry watchdog runs with its default configuration on
+	// the nodes whose app.toml predates it
+	ibcHealthConfig := gaiaappparams.DefaultIBCHealthConfig()
+	if appOpts.Get(gaiaappparams.IBCHealthCheckIntervalKey) != nil {
+		ibcHealthConfig.CheckInterval = cast.ToUint64(appOpts.Get(gaiaappparams.IBCHealthCheckIntervalKey))
+		ibcHealthConfig.WarningThresholds = cast.ToDurationSlice(appOpts.Get(gaiaappparams.IBCHealthWarningThresholdsKey))
+	}
+	app.ibcHealthWatchdog = ibchealthkeeper.NewWatchdog(ibcHealthQuerier, ibcHealthConfig.CheckInterval, ibcHealthConfig.WarningThresholds)
+	app.telemetryEnabled = cast.ToBool(appOpts.Get("telemetry.enabled"))
+
+	// add test gRPC service for testing gRPC queries in isolation, only
+	// registered when built with the test_services tag
+	registerTestServices(app)
+
+	if err := app.auditServices(); err != nil {
+		panic(fmt.Errorf("failed to audit registered services: %w", err))
+	}
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
 	// NOTE: this is not required apps that don't use the simulator for fuzz testing
 	// transactions
-	app.sm = module.NewSimulationManager(
-		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
-		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
-		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
-		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
-		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil),
-		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
-		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-		params.NewAppModule(app.ParamsKeeper),
-		evidence.NewAppModule(app.EvidenceKeeper),
-		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
-		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
-		liquidity.NewAppModule(appCodec, app.LiquidityKeeper, app.AccountKeeper, app.BankKeeper, app.DistrKeeper),
-		ibc.NewAppModule(app.IBCKeeper),
-		transferModule,
-	)
+	app.sm = module.NewSimulationManager(appModules.simulationModules(app.mm, map[string]module.AppModuleSimulation{
+		authtypes.ModuleName: auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
+	})...)
 
 	app.sm.RegisterStoreDecoders()
 
@@ -681,6 +774,9 @@ func NewGaiaApp(
 	app.MountKVStores(keys)
 	app.MountTransientStores(tkeys)
 	app.MountMemoryStores(memKeys)
+	if err := appModules.validateStores(app.CommitMultiStore()); err != nil {
+		panic(fmt.Errorf("invalid module registry: %w", err))
+	}
 
 	// anteHandler, err := gaiaante.NewAnteHandler(
 	// 	gaiaante.HandlerOptions{
@@ -761,7 +857,7 @@ func NewGaiaApp(
 
 	if upgradeInfo.Name == upgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
 		storeUpgrades := storetypes.StoreUpgrades{
-			Added: []string{icahosttypes.StoreKey},
+			Added: []string{icahosttypes.StoreKey, autocompoundtypes.StoreKey, liquidstakingtypes.StoreKey, feeabstypes.StoreKey, ratelimittypes.StoreKey, ibchookstypes.StoreKey, recoverytypes.StoreKey},
 		}
 
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
@@ -785,17 +881,21 @@ func (app *GaiaApp) setTxHandler(txConfig client.TxConfig, indexEventsStr []stri
 	for _, e := range indexEventsStr {
 		indexEvents[e] = struct{}{}
 	}
-	txHandler, err := authmiddleware.NewDefaultTxHandler(authmiddleware.TxHandlerOptions{
-		Debug:            app.Trace(),
-		IndexEvents:      indexEvents,
-		LegacyRouter:     app.legacyRouter,
-		MsgServiceRouter: app.msgSvcRouter,
-		AccountKeeper:    app.AccountKeeper,
-		BankKeeper:       app.BankKeeper,
-		FeegrantKeeper:   app.FeeGrantKeeper,
-		SignModeHandler:  txConfig.SignModeHandler(),
-		SigGasConsumer:   authmiddleware.DefaultSigVerificationGasConsumer,
-		TxDecoder:        txConfig.TxDecoder(),
+	txHandler, err := gaiaante.NewTxHandler(gaiaante.TxHandlerOptions{
+		TxHandlerOptions: authmiddleware.TxHandlerOptions{
+			Debug:            app.Trace(),
+			IndexEvents:      indexEvents,
+			LegacyRouter:     app.legacyRouter,
+			MsgServiceRouter: app.msgSvcRouter,
+			AccountKeeper:    app.AccountKeeper,
+			BankKeeper:       app.BankKeeper,
+			FeegrantKeeper:   app.FeeGrantKeeper,
+			SignModeHandler:  txConfig.SignModeHandler(),
+			SigGasConsumer:   authmiddleware.DefaultSigVerificationGasConsumer,
+			TxDecoder:        txConfig.TxDecoder(),
+			TxFeeChecker:     gaiaante.NewMempoolFeeChecker(app.FeeAbsKeeper).CheckTxFee,
+		},
+		FeeEscrower: app.FeeAbsKeeper,
 	})
 	if err != nil {
 		panic(err)
@@ -814,6 +914,13 @@ func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) ab
 
 // EndBlocker application updates every end block
 func (app *GaiaApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	// liquidity batches are executed by the module's EndBlocker, so they must
+	// be observed before running it
+	if app.telemetryEnabled {
+		gaiatelemetry.ObserveLiquidityBatches(ctx, app.LiquidityKeeper)
+	}
+	app.ibcHealthWatchdog.Check(ctx)
+
 	return app.mm.EndBlock(ctx, req)
 }
 
@@ -894,6 +1001,16 @@ func (app *GaiaApp) GetSubspace(moduleName string) paramstypes.Subspace {
 	return subspace
 }
 
+// ModuleOrders returns the begin block, end block and init genesis orders of
+// the app's modules.
+func (app *GaiaApp) ModuleOrders() ModuleOrders {
+	return ModuleOrders{
+		BeginBlockers: app.mm.OrderBeginBlockers,
+		EndBlockers:   app.mm.OrderEndBlockers,
+		InitGenesis:   app.mm.OrderInitGenesis,
+	}
+}
+
 // SimulationManager implements the SimulationApp interface
 func (app *GaiaApp) SimulationManager() *module.SimulationManager {
 	return app.sm
@@ -912,6 +1029,26 @@ func (app *GaiaApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register grpc-gateway routes for the Gaia query services.
+	if err := swaptypes.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, swaptypes.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+	if err := swaptypes.RegisterHistoryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, swaptypes.NewHistoryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+	if err := txhistorytypes.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, txhistorytypes.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+	if err := portfoliotypes.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, portfoliotypes.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+	if err := aprtypes.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, aprtypes.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+	if err := ibchealthtypes.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, ibchealthtypes.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
 		RegisterSwaggerAPI(apiSvr.Router)
@@ -928,13 +1065,20 @@ func (app *GaiaApp) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.interfaceRegistry)
 }
 
-// RegisterSwaggerAPI registers swagger route with API Server
+// RegisterSwaggerAPI registers swagger route with API Server. The swagger UI
+// assets are served from the SDK's statik filesystem, while the specification
+// itself is Gaia's own, generated from the modules registered in the app.
 func RegisterSwaggerAPI(rtr *mux.Router) {
 	statikFS, err := fs.New()
 	if err != nil {
 		panic(err)
 	}
 
+	// the swagger UI loads the specification from ./swagger.yaml; JSON being a
+	// subset of YAML, Gaia's specification can be served in its place
+	rtr.Path("/swagger/swagger.yaml").Handler(gaiadocs.Handler())
+	rtr.Path("/swagger/openapi.json").Handler(gaiadocs.Handler())
+
 	staticServer := http.FileServer(statikFS)
 	rtr.PathPrefix("/swagger/").Handler(http.StripPrefix("/swagger/", staticServer))
 }
@@ -957,6 +1101,12 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 
 	// paramsKeeper.Subspace(routertypes.ModuleName).WithKeyTable(routertypes.ParamKeyTable())
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(autocompoundtypes.ModuleName)
+	paramsKeeper.Subspace(liquidstakingtypes.ModuleName)
+	paramsKeeper.Subspace(feeabstypes.ModuleName)
+	paramsKeeper.Subspace(ratelimittypes.ModuleName)
+	paramsKeeper.Subspace(denomfiltertypes.ModuleName)
+	paramsKeeper.Subspace(ibchookstypes.ModuleName)
 
 	return paramsKeeper
 }
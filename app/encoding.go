@@ -2,18 +2,14 @@ package gaia
 
 import (
 	"github.com/cosmos/gaia/v8/app/params"
-
-	"github.com/cosmos/cosmos-sdk/std"
 )
 
-// MakeTestEncodingConfig creates an EncodingConfig for testing. This function
-// should be used only in tests or when creating a new app instance (NewApp*()).
-// App user shouldn't create new codecs - use the app.AppCodec instead.
-// [DEPRECATED]
-func MakeTestEncodingConfig() params.EncodingConfig {
-	encodingConfig := params.MakeTestEncodingConfig()
-	std.RegisterLegacyAminoCodec(encodingConfig.Amino)
-	std.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+// MakeEncodingConfig creates the EncodingConfig of the app, registering the
+// interfaces and amino types of every module in ModuleBasics. This function
+// should be used only when creating a new app instance (NewApp*()) or in
+// tests. App user shouldn't create new codecs - use the app.AppCodec instead.
+func MakeEncodingConfig() params.EncodingConfig {
+	encodingConfig := params.MakeEncodingConfig()
 	ModuleBasics.RegisterLegacyAminoCodec(encodingConfig.Amino)
 	ModuleBasics.RegisterInterfaces(encodingConfig.InterfaceRegistry)
 	return encodingConfig
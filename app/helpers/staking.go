@@ -0,0 +1,105 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiaapp "github.com/cosmos/gaia/v8/app"
+)
+
+// StakingFixture is a GaiaApp with a bonded validator, on top of the genesis
+// validator bonding 1 000 000 tokens, used to test the modules built on the
+// staking and distribution modules.
+type StakingFixture struct {
+	App       *gaiaapp.GaiaApp
+	Ctx       sdk.Context
+	BondDenom string
+	Validator sdk.ValAddress
+}
+
+// SetupStaking creates a GaiaApp at height 1, with a bonded validator
+// self-delegating the given amount of tokens.
+func SetupStaking(t *testing.T, selfDelegation sdk.Int) StakingFixture {
+	t.Helper()
+
+	app := Setup(t, false, 0)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	f := StakingFixture{
+		App:       app,
+		Ctx:       ctx,
+		BondDenom: app.StakingKeeper.BondDenom(ctx),
+	}
+
+	operator := sdk.AccAddress("operator____________")
+	f.Fund(t, operator, selfDelegation)
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(operator),
+		ed25519.GenPrivKey().PubKey(),
+		sdk.NewCoin(f.BondDenom, selfDelegation),
+		stakingtypes.Description{Moniker: "validator"},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	f.Validator = sdk.ValAddress(operator)
+
+	return f
+}
+
+// Fund mints an amount of bond denom tokens to an account.
+func (f StakingFixture) Fund(t *testing.T, addr sdk.AccAddress, amount sdk.Int) {
+	t.Helper()
+
+	coins := sdk.NewCoins(sdk.NewCoin(f.BondDenom, amount))
+	require.NoError(t, f.App.BankKeeper.MintCoins(f.Ctx, minttypes.ModuleName, coins))
+	require.NoError(t, f.App.BankKeeper.SendCoinsFromModuleToAccount(f.Ctx, minttypes.ModuleName, addr, coins))
+}
+
+// Delegate funds a delegator with an amount of tokens, and delegates them to
+// the validator.
+func (f StakingFixture) Delegate(t *testing.T, delegator sdk.AccAddress, amount sdk.Int) {
+	t.Helper()
+
+	f.Fund(t, delegator, amount)
+	validator, found := f.App.StakingKeeper.GetValidator(f.Ctx, f.Validator)
+	require.True(t, found)
+	_, err := f.App.StakingKeeper.Delegate(f.Ctx, delegator, amount, stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+}
+
+// Delegation returns the tokens delegated to the validator by a delegator,
+// zero if it has no delegation.
+func (f StakingFixture) Delegation(delegator sdk.AccAddress) sdk.Int {
+	delegation, found := f.App.StakingKeeper.GetDelegation(f.Ctx, delegator, f.Validator)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	validator, _ := f.App.StakingKeeper.GetValidator(f.Ctx, f.Validator)
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// AllocateRewards mints an amount of tokens, and allocates them as rewards to
+// the delegators of the validator.
+func (f StakingFixture) AllocateRewards(t *testing.T, amount sdk.Int) {
+	t.Helper()
+
+	coins := sdk.NewCoins(sdk.NewCoin(f.BondDenom, amount))
+	require.NoError(t, f.App.BankKeeper.MintCoins(f.Ctx, minttypes.ModuleName, coins))
+	require.NoError(t, f.App.BankKeeper.SendCoinsFromModuleToModule(f.Ctx, minttypes.ModuleName, distrtypes.ModuleName, coins))
+
+	validator, found := f.App.StakingKeeper.GetValidator(f.Ctx, f.Validator)
+	require.True(t, found)
+	f.App.DistrKeeper.AllocateTokensToValidator(f.Ctx, validator, sdk.NewDecCoinsFromCoins(coins...))
+}
@@ -12,7 +12,14 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gaiaapp "github.com/cosmos/gaia/v8/app"
 	"github.com/cosmos/gaia/v8/app/params"
 )
@@ -50,6 +57,8 @@ func Setup(t *testing.T, isCheckTx bool, invCheckPeriod uint) *gaiaapp.GaiaApp {
 
 	app, genesisState := setup(!isCheckTx, invCheckPeriod)
 	if !isCheckTx {
+		genesisState = genesisStateWithValSet(t, app, genesisState)
+
 		// InitChain must be called to stop deliverState from being nil
 		stateBytes, err := json.MarshalIndent(genesisState, "", " ")
 		require.NoError(t, err)
@@ -67,6 +76,60 @@ func Setup(t *testing.T, isCheckTx bool, invCheckPeriod uint) *gaiaapp.GaiaApp {
 	return app
 }
 
+// genesisStateWithValSet adds a bonded validator, self-delegated by a funded
+// genesis account, to the genesis state, as InitChain fails without one.
+func genesisStateWithValSet(t *testing.T, app *gaiaapp.GaiaApp, genesisState gaiaapp.GenesisState) gaiaapp.GenesisState {
+	t.Helper()
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), []authtypes.GenesisAccount{acc})
+	genesisState[authtypes.ModuleName] = app.AppCodec().MustMarshalJSON(authGenesis)
+
+	bondAmt := sdk.DefaultPowerReduction
+	validator := stakingtypes.Validator{
+		OperatorAddress:   sdk.ValAddress(pubKey.Address()).String(),
+		ConsensusPubkey:   pkAny,
+		Jailed:            false,
+		Status:            stakingtypes.Bonded,
+		Tokens:            bondAmt,
+		DelegatorShares:   sdk.OneDec(),
+		Description:       stakingtypes.Description{},
+		UnbondingHeight:   int64(0),
+		UnbondingTime:     time.Unix(0, 0).UTC(),
+		Commission:        stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		MinSelfDelegation: sdk.ZeroInt(),
+	}
+	delegation := stakingtypes.NewDelegation(acc.GetAddress(), validator.GetOperator(), sdk.OneDec())
+
+	stakingGenesis := stakingtypes.NewGenesisState(stakingtypes.DefaultParams(), []stakingtypes.Validator{validator}, []stakingtypes.Delegation{delegation})
+	genesisState[stakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(stakingGenesis)
+
+	bondDenom := stakingtypes.DefaultParams().BondDenom
+	balances := []banktypes.Balance{
+		{
+			Address: acc.GetAddress().String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(100000000000000))),
+		},
+		{
+			Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(bondDenom, bondAmt)),
+		},
+	}
+	totalSupply := sdk.NewCoins()
+	for _, b := range balances {
+		totalSupply = totalSupply.Add(b.Coins...)
+	}
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{})
+	genesisState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(bankGenesis)
+
+	return genesisState
+}
+
 // SetupOptions defines arguments that are passed into `Simapp` constructor.
 type SetupOptions struct {
 	Logger             log.Logger
@@ -80,7 +143,7 @@ type SetupOptions struct {
 
 func setup(withGenesis bool, invCheckPeriod uint) (*gaiaapp.GaiaApp, gaiaapp.GenesisState) {
 	db := dbm.NewMemDB()
-	encCdc := gaiaapp.MakeTestEncodingConfig()
+	encCdc := gaiaapp.MakeEncodingConfig()
 	app := gaiaapp.NewGaiaApp(
 		log.NewNopLogger(),
 		db,
@@ -0,0 +1,269 @@
+package gaia
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// orderConstraints declares the constraints on the position of a module in
+// one of the module orders of the app.
+type orderConstraints struct {
+	// first requires the module to run before all other modules.
+	first bool
+	// last requires the module to run after all other modules.
+	last bool
+	// after lists the modules which must run before the module.
+	after []string
+	// before lists the modules which must run after the module.
+	before []string
+}
+
+// orderPhase is one of the module orders of the app.
+type orderPhase struct {
+	name        string
+	constraints func(moduleConfig) orderConstraints
+	// legacy is the order the modules it lists must keep relative to each
+	// other, ahead of the other modules unless their constraints require
+	// otherwise.
+	legacy []string
+}
+
+var (
+	beginBlockPhase  = orderPhase{"begin block", func(m moduleConfig) orderConstraints { return m.beginBlock }, legacyBeginBlockers}
+	endBlockPhase    = orderPhase{"end block", func(m moduleConfig) orderConstraints { return m.endBlock }, legacyEndBlockers}
+	initGenesisPhase = orderPhase{"init genesis", func(m moduleConfig) orderConstraints { return m.initGenesis }, legacyInitGenesis}
+
+	orderPhases = []orderPhase{beginBlockPhase, endBlockPhase, initGenesisPhase}
+)
+
+// ModuleOrders holds the begin block, end block and init genesis orders of
+// the modules of the app.
+type ModuleOrders struct {
+	BeginBlockers []string
+	EndBlockers   []string
+	InitGenesis   []string
+}
+
+// orders computes the module orders satisfying the ordering constraints of
+// the registered modules.
+func (r moduleRegistry) orders() (ModuleOrders, error) {
+	var (
+		orders ModuleOrders
+		err    error
+	)
+
+	if orders.BeginBlockers, err = r.order(beginBlockPhase); err != nil {
+		return ModuleOrders{}, err
+	}
+	if orders.EndBlockers, err = r.order(endBlockPhase); err != nil {
+		return ModuleOrders{}, err
+	}
+	if orders.InitGenesis, err = r.order(initGenesisPhase); err != nil {
+		return ModuleOrders{}, err
+	}
+
+	return orders, nil
+}
+
+// order computes the order of the registered modules in the given phase. The
+// modules are topologically sorted according to their constraints and the
+// legacy order of the phase; modules without constraints between them keep
+// their legacy order, then their registry order.
+func (r moduleRegistry) order(phase orderPhase) ([]string, error) {
+	edges, err := r.edges(phase)
+	if err != nil {
+		return nil, err
+	}
+	base := r.baseOrder(phase)
+
+	// number of modules which must run before each module
+	pending := make(map[string]int, len(r))
+	for _, successors := range edges {
+		for next := range successors {
+			pending[next]++
+		}
+	}
+
+	order := make([]string, 0, len(r))
+	done := make(map[string]bool, len(r))
+	for len(order) < len(r) {
+		// pick the first module in base order whose predecessors all ran
+		next := ""
+		for _, name := range base {
+			if !done[name] && pending[name] == 0 {
+				next = name
+				break
+			}
+		}
+
+		if next == "" {
+			var cycle []string
+			for _, name := range base {
+				if !done[name] {
+					cycle = append(cycle, name)
+				}
+			}
+
+			return nil, fmt.Errorf("%s order constraints contain a cycle between modules %s", phase.name, strings.Join(cycle, ", "))
+		}
+
+		done[next] = true
+		order = append(order, next)
+		for successor := range edges[next] {
+			pending[successor]--
+		}
+	}
+
+	return order, nil
+}
+
+// legacyOrder returns the registered modules of the legacy order of the given
+// phase, in legacy order.
+func (r moduleRegistry) legacyOrder(phase orderPhase) []string {
+	registered := make(map[string]bool, len(r))
+	for _, m := range r {
+		registered[m.name] = true
+	}
+
+	var order []string
+	for _, name := range phase.legacy {
+		if registered[name] {
+			order = append(order, name)
+		}
+	}
+
+	return order
+}
+
+// baseOrder returns the registered modules of the legacy order of the given
+// phase, followed by the other registered modules in registry order.
+func (r moduleRegistry) baseOrder(phase orderPhase) []string {
+	base := r.legacyOrder(phase)
+	inBase := make(map[string]bool, len(r))
+	for _, name := range base {
+		inBase[name] = true
+	}
+	for _, m := range r {
+		if !inBase[m.name] {
+			base = append(base, m.name)
+		}
+	}
+
+	return base
+}
+
+// edges returns, for each registered module, the set of modules which must run
+// after it in the given phase: the modules it is constrained to run before,
+// and the module following it in the legacy order of the phase.
+func (r moduleRegistry) edges(phase orderPhase) (map[string]map[string]bool, error) {
+	edges := make(map[string]map[string]bool, len(r))
+	addEdge := func(from, to string) {
+		if edges[from] == nil {
+			edges[from] = make(map[string]bool)
+		}
+		edges[from][to] = true
+	}
+
+	var first, last string
+	for _, m := range r {
+		c := phase.constraints(m)
+
+		if c.first {
+			if first != "" {
+				return nil, fmt.Errorf("modules %s and %s are both required to run first in the %s order", first, m.name, phase.name)
+			}
+			first = m.name
+		}
+		if c.last {
+			if last != "" {
+				return nil, fmt.Errorf("modules %s and %s are both required to run last in the %s order", last, m.name, phase.name)
+			}
+			last = m.name
+		}
+
+		for _, name := range c.after {
+			addEdge(name, m.name)
+		}
+		for _, name := range c.before {
+			addEdge(m.name, name)
+		}
+	}
+
+	// the registered modules of the legacy order keep their relative order
+	legacy := r.legacyOrder(phase)
+	for i := 1; i < len(legacy); i++ {
+		addEdge(legacy[i-1], legacy[i])
+	}
+
+	for _, m := range r {
+		if first != "" && m.name != first {
+			addEdge(first, m.name)
+		}
+		if last != "" && m.name != last {
+			addEdge(m.name, last)
+		}
+	}
+
+	return edges, nil
+}
+
+// validateOrders verifies that the orders set on the module manager contain
+// every registered module exactly once and satisfy the ordering constraints
+// of the registered modules.
+func (r moduleRegistry) validateOrders(mm *module.Manager) error {
+	orders := []struct {
+		phase orderPhase
+		order []string
+	}{
+		{beginBlockPhase, mm.OrderBeginBlockers},
+		{endBlockPhase, mm.OrderEndBlockers},
+		{initGenesisPhase, mm.OrderInitGenesis},
+	}
+
+	for _, o := range orders {
+		if err := r.validateOrder(o.phase, o.order); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r moduleRegistry) validateOrder(phase orderPhase, order []string) error {
+	positions := make(map[string]int, len(order))
+	for i, name := range order {
+		if _, ok := positions[name]; ok {
+			return fmt.Errorf("module %s appears more than once in the %s order", name, phase.name)
+		}
+		positions[name] = i
+	}
+
+	var missing []string
+	for _, m := range r {
+		if _, ok := positions[m.name]; !ok {
+			missing = append(missing, m.name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("modules missing from the %s order: %s", phase.name, strings.Join(missing, ", "))
+	}
+	if len(order) != len(r) {
+		return fmt.Errorf("the %s order contains %d modules, expected %d", phase.name, len(order), len(r))
+	}
+
+	edges, err := r.edges(phase)
+	if err != nil {
+		return err
+	}
+	for _, m := range r {
+		for next := range edges[m.name] {
+			if positions[m.name] > positions[next] {
+				return fmt.Errorf("module %s must run before module %s in the %s order", m.name, next, phase.name)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package gaia
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleRegistryOrders(t *testing.T) {
+	registry := moduleRegistry{
+		{name: "a", beginBlock: orderConstraints{after: []string{"c"}}},
+		{name: "b", beginBlock: orderConstraints{first: true}},
+		{name: "c"},
+		{name: "d", beginBlock: orderConstraints{before: []string{"c"}}},
+	}
+
+	order, err := registry.order(beginBlockPhase)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "d", "c", "a"}, order)
+	require.NoError(t, registry.validateOrder(beginBlockPhase, order))
+
+	// modules without constraints keep their registry order
+	order, err = registry.order(endBlockPhase)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c", "d"}, order)
+
+	require.EqualError(t,
+		registry.validateOrder(beginBlockPhase, []string{"b", "c", "d", "a"}),
+		"module d must run before module c in the begin block order",
+	)
+	require.EqualError(t,
+		registry.validateOrder(beginBlockPhase, []string{"b", "d", "c"}),
+		"modules missing from the begin block order: a",
+	)
+
+	// the modules of the legacy order keep their relative order, ahead of
+	// the other modules unless their constraints require otherwise
+	legacyPhase := orderPhase{"legacy", func(m moduleConfig) orderConstraints { return m.initGenesis }, []string{"c", "a", "unregistered"}}
+	legacyRegistry := moduleRegistry{
+		{name: "a"},
+		{name: "b", initGenesis: orderConstraints{before: []string{"a"}}},
+		{name: "c"},
+		{name: "d"},
+	}
+	order, err = legacyRegistry.order(legacyPhase)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "b", "a", "d"}, order)
+	require.EqualError(t,
+		legacyRegistry.validateOrder(legacyPhase, []string{"b", "a", "c", "d"}),
+		"module c must run before module a in the legacy order",
+	)
+
+	registry[2].beginBlock = orderConstraints{after: []string{"a"}}
+	_, err = registry.order(beginBlockPhase)
+	require.EqualError(t, err, "begin block order constraints contain a cycle between modules a, c")
+
+	registry[2].beginBlock = orderConstraints{first: true}
+	_, err = registry.order(beginBlockPhase)
+	require.EqualError(t, err, "modules b and c are both required to run first in the begin block order")
+}
+
+func TestAppModulesOrders(t *testing.T) {
+	orders, err := appModules.orders()
+	require.NoError(t, err)
+
+	// the orders of Gaia v7, followed by the modules added since then
+	require.Equal(t, []string{
+		"upgrade", "capability", "mint", "distribution", "slashing", "evidence", "staking", "auth", "bank", "gov", "crisis",
+		"liquidity", "transfer", "ibc", "interchainaccounts", "genutil", "authz", "feegrant", "group", "params", "vesting",
+		"autocompound", "liquidstaking", "feeabs", "ratelimit", "denomfilter", "ibchooks", "recovery",
+	}, orders.BeginBlockers)
+	// the auto-compounded rewards are delegated before the validator set
+	// updates of the staking module
+	require.Equal(t, []string{
+		"crisis", "gov", "autocompound", "staking", "liquidity", "transfer", "ibc", "interchainaccounts", "capability", "auth",
+		"bank", "distribution", "slashing", "mint", "genutil", "evidence", "authz", "feegrant", "group", "params", "upgrade",
+		"vesting", "liquidstaking", "feeabs", "ratelimit", "denomfilter", "ibchooks", "recovery",
+	}, orders.EndBlockers)
+	require.Equal(t, []string{
+		"capability", "auth", "bank", "distribution", "staking", "slashing", "gov", "mint", "crisis", "genutil", "transfer",
+		"ibc", "interchainaccounts", "evidence", "liquidity", "authz", "feegrant", "group", "params", "upgrade", "vesting",
+		"autocompound", "liquidstaking", "feeabs", "ratelimit", "denomfilter", "ibchooks", "recovery",
+	}, orders.InitGenesis)
+}
@@ -0,0 +1,488 @@
+package gaia
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	liquiditytypes "github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+	// routertypes "github.com/strangelove-ventures/packet-forward-middleware/v2/router/types"
+
+	autocompoundtypes "github.com/cosmos/gaia/v8/x/autocompound/types"
+	denomfiltertypes "github.com/cosmos/gaia/v8/x/denomfilter/types"
+	feeabstypes "github.com/cosmos/gaia/v8/x/feeabs/types"
+	ibchookstypes "github.com/cosmos/gaia/v8/x/ibchooks/types"
+	liquidstakingtypes "github.com/cosmos/gaia/v8/x/liquidstaking/types"
+	ratelimittypes "github.com/cosmos/gaia/v8/x/ratelimit/types"
+	recoverytypes "github.com/cosmos/gaia/v8/x/recovery/types"
+)
+
+// moduleConfig describes how a module is wired into the app: the stores it
+// owns, the constraints on its position in the begin block, end block and
+// init genesis orders, and whether it participates in simulations.
+type moduleConfig struct {
+	name string
+
+	kvStoreKeys        []string
+	transientStoreKeys []string
+	memStoreKeys       []string
+
+	beginBlock  orderConstraints
+	endBlock    orderConstraints
+	initGenesis orderConstraints
+
+	simulation bool
+}
+
+// moduleRegistry lists the configuration of every module of the app. Modules
+// participating in simulations are simulated in registry order, and modules
+// without ordering constraints between them are ordered in registry order.
+type moduleRegistry []moduleConfig
+
+// appModules is the registry of the modules of the app, from which the store
+// keys, the module orders and the simulation manager are derived.
+//
+// The ordering constraints of a module only declare what it actually depends
+// on. The orders of the modules of Gaia v7 are additionally pinned by the
+// legacy orders below, and the modules added since then are registered after
+// them, so that they run after the modules of v7 unless their own constraints
+// require otherwise.
+var appModules = moduleRegistry{
+	{
+		name:        authtypes.ModuleName,
+		kvStoreKeys: []string{authtypes.StoreKey},
+		simulation:  true,
+	},
+	{
+		name:        banktypes.ModuleName,
+		kvStoreKeys: []string{banktypes.StoreKey},
+		simulation:  true,
+	},
+	{
+		name:         capabilitytypes.ModuleName,
+		kvStoreKeys:  []string{capabilitytypes.StoreKey},
+		memStoreKeys: []string{capabilitytypes.MemStoreKey},
+		// capability module's beginblocker must come before any modules using
+		// capabilities (e.g. IBC)
+		beginBlock: orderConstraints{before: []string{ibchost.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName}},
+		// capability module must occur first so that it can initialize any
+		// capabilities so that other modules that want to create or claim
+		// capabilities afterwards in InitChain can do so safely.
+		initGenesis: orderConstraints{first: true},
+		simulation:  true,
+	},
+	{
+		name:        feegrant.ModuleName,
+		kvStoreKeys: []string{feegrant.StoreKey},
+		simulation:  true,
+	},
+	{
+		name:        govtypes.ModuleName,
+		kvStoreKeys: []string{govtypes.StoreKey},
+		// proposals are executed before the validator set updates of the block
+		endBlock: orderConstraints{before: []string{stakingtypes.ModuleName}},
+		// deposits are checked against the gov module account balance
+		initGenesis: orderConstraints{after: []string{banktypes.ModuleName}},
+		simulation:  true,
+	},
+	{
+		name:        minttypes.ModuleName,
+		kvStoreKeys: []string{minttypes.StoreKey},
+		// minted tokens are distributed in the same block
+		beginBlock: orderConstraints{before: []string{distrtypes.ModuleName}},
+		simulation: true,
+	},
+	{
+		name:        stakingtypes.ModuleName,
+		kvStoreKeys: []string{stakingtypes.StoreKey},
+		// staking module is required if HistoricalEntries param > 0, and
+		// tracks the historical info once rewards and slashes are applied
+		beginBlock: orderConstraints{after: []string{distrtypes.ModuleName, slashingtypes.ModuleName, evidencetypes.ModuleName}},
+		// bonded and not bonded pools are checked against their balances, and
+		// the hooks of the genesis validators initialize their distribution
+		// records
+		initGenesis: orderConstraints{after: []string{banktypes.ModuleName, distrtypes.ModuleName}},
+		simulation:  true,
+	},
+	{
+		name:        distrtypes.ModuleName,
+		kvStoreKeys: []string{distrtypes.StoreKey},
+		// slashing happens after distr.BeginBlocker so that there is nothing
+		// left over in the validator fee pool, so as to keep the
+		// CanWithdrawInvariant invariant.
+		beginBlock: orderConstraints{before: []string{slashingtypes.ModuleName}},
+		// the module account balance is checked against the fee pool and the
+		// outstanding rewards
+		initGenesis: orderConstraints{after: []string{banktypes.ModuleName}},
+		simulation:  true,
+	},
+	{
+		name:        slashingtypes.ModuleName,
+		kvStoreKeys: []string{slashingtypes.StoreKey},
+		// the consensus public keys of the validators are read from staking
+		initGenesis: orderConstraints{after: []string{stakingtypes.ModuleName}},
+		simulation:  true,
+	},
+	{
+		name:               paramstypes.ModuleName,
+		kvStoreKeys:        []string{paramstypes.StoreKey},
+		transientStoreKeys: []string{paramstypes.TStoreKey},
+		simulation:         true,
+	},
+	{
+		name:        evidencetypes.ModuleName,
+		kvStoreKeys: []string{evidencetypes.StoreKey},
+		simulation:  true,
+	},
+	{
+		name:        authz.ModuleName,
+		kvStoreKeys: []string{authzkeeper.StoreKey},
+		simulation:  true,
+	},
+	{
+		name:        group.ModuleName,
+		kvStoreKeys: []string{group.StoreKey},
+		simulation:  true,
+	},
+	{
+		name:        liquiditytypes.ModuleName,
+		kvStoreKeys: []string{liquiditytypes.StoreKey},
+		// pool reserves are checked against their balances
+		initGenesis: orderConstraints{after: []string{banktypes.ModuleName}},
+		simulation:  true,
+	},
+	{
+		name:        ibchost.ModuleName,
+		kvStoreKeys: []string{ibchost.StoreKey},
+		simulation:  true,
+	},
+	{
+		name:        ibctransfertypes.ModuleName,
+		kvStoreKeys: []string{ibctransfertypes.StoreKey},
+		simulation:  true,
+	},
+	{
+		name:        upgradetypes.ModuleName,
+		kvStoreKeys: []string{upgradetypes.StoreKey},
+		// upgrades should be run first
+		beginBlock: orderConstraints{first: true},
+	},
+	{
+		name: crisistypes.ModuleName,
+		// invariants are asserted before the state is modified by the other
+		// end blockers
+		endBlock: orderConstraints{first: true},
+	},
+	{
+		name: genutiltypes.ModuleName,
+		// the genutils module must occur after staking so that pools are
+		// properly initialized with tokens from genesis accounts, and after
+		// auth so that it can access the params from auth.
+		initGenesis: orderConstraints{after: []string{authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName}},
+	},
+	{
+		name: vestingtypes.ModuleName,
+	},
+	{
+		// the interchain accounts module only runs the host submodule
+		name:        icatypes.ModuleName,
+		kvStoreKeys: []string{icahosttypes.StoreKey},
+	},
+	{
+		name:        autocompoundtypes.ModuleName,
+		kvStoreKeys: []string{autocompoundtypes.StoreKey},
+		// compounded rewards are delegated before the validator set updates
+		// of the block
+		endBlock: orderConstraints{before: []string{stakingtypes.ModuleName}},
+	},
+	{
+		name:        liquidstakingtypes.ModuleName,
+		kvStoreKeys: []string{liquidstakingtypes.StoreKey},
+	},
+	{
+		name:        feeabstypes.ModuleName,
+		kvStoreKeys: []string{feeabstypes.StoreKey},
+	},
+	{
+		name:        ratelimittypes.ModuleName,
+		kvStoreKeys: []string{ratelimittypes.StoreKey},
+	},
+	{
+		name: denomfiltertypes.ModuleName,
+	},
+	{
+		name:        ibchookstypes.ModuleName,
+		kvStoreKeys: []string{ibchookstypes.StoreKey},
+	},
+	{
+		name:        recoverytypes.ModuleName,
+		kvStoreKeys: []string{recoverytypes.StoreKey},
+	},
+	// {
+	// 	name:        routertypes.ModuleName,
+	// 	kvStoreKeys: []string{routertypes.StoreKey},
+	// 	initGenesis: orderConstraints{after: []string{ibctransfertypes.ModuleName}},
+	// },
+}
+
+// The begin block, end block and init genesis orders of the modules of Gaia
+// v7, which are consensus critical: the computed orders keep these modules in
+// the same relative order.
+var (
+	legacyBeginBlockers = []string{
+		upgradetypes.ModuleName,
+		capabilitytypes.ModuleName,
+		minttypes.ModuleName,
+		distrtypes.ModuleName,
+		slashingtypes.ModuleName,
+		evidencetypes.ModuleName,
+		stakingtypes.ModuleName,
+		authtypes.ModuleName,
+		banktypes.ModuleName,
+		govtypes.ModuleName,
+		crisistypes.ModuleName,
+		liquiditytypes.ModuleName,
+		ibctransfertypes.ModuleName,
+		ibchost.ModuleName,
+		icatypes.ModuleName,
+		genutiltypes.ModuleName,
+		authz.ModuleName,
+		feegrant.ModuleName,
+		group.ModuleName,
+		paramstypes.ModuleName,
+		vestingtypes.ModuleName,
+	}
+	legacyEndBlockers = []string{
+		crisistypes.ModuleName,
+		govtypes.ModuleName,
+		stakingtypes.ModuleName,
+		liquiditytypes.ModuleName,
+		ibctransfertypes.ModuleName,
+		ibchost.ModuleName,
+		icatypes.ModuleName,
+		capabilitytypes.ModuleName,
+		authtypes.ModuleName,
+		banktypes.ModuleName,
+		distrtypes.ModuleName,
+		slashingtypes.ModuleName,
+		minttypes.ModuleName,
+		genutiltypes.ModuleName,
+		evidencetypes.ModuleName,
+		authz.ModuleName,
+		feegrant.ModuleName,
+		group.ModuleName,
+		paramstypes.ModuleName,
+		upgradetypes.ModuleName,
+		vestingtypes.ModuleName,
+	}
+	legacyInitGenesis = []string{
+		capabilitytypes.ModuleName,
+		authtypes.ModuleName,
+		banktypes.ModuleName,
+		distrtypes.ModuleName,
+		stakingtypes.ModuleName,
+		slashingtypes.ModuleName,
+		govtypes.ModuleName,
+		minttypes.ModuleName,
+		crisistypes.ModuleName,
+		genutiltypes.ModuleName,
+		ibctransfertypes.ModuleName,
+		ibchost.ModuleName,
+		icatypes.ModuleName,
+		evidencetypes.ModuleName,
+		liquiditytypes.ModuleName,
+		authz.ModuleName,
+		feegrant.ModuleName,
+		group.ModuleName,
+		paramstypes.ModuleName,
+		upgradetypes.ModuleName,
+		vestingtypes.ModuleName,
+	}
+)
+
+// names returns the names of the registered modules, in registry order.
+func (r moduleRegistry) names() []string {
+	names := make([]string, len(r))
+	for i, m := range r {
+		names[i] = m.name
+	}
+
+	return names
+}
+
+// kvStoreKeys returns the KV store keys of all registered modules.
+func (r moduleRegistry) kvStoreKeys() []string {
+	var keys []string
+	for _, m := range r {
+		keys = append(keys, m.kvStoreKeys...)
+	}
+
+	return keys
+}
+
+// transientStoreKeys returns the transient store keys of all registered modules.
+func (r moduleRegistry) transientStoreKeys() []string {
+	var keys []string
+	for _, m := range r {
+		keys = append(keys, m.transientStoreKeys...)
+	}
+
+	return keys
+}
+
+// memStoreKeys returns the memory store keys of all registered modules.
+func (r moduleRegistry) memStoreKeys() []string {
+	var keys []string
+	for _, m := range r {
+		keys = append(keys, m.memStoreKeys...)
+	}
+
+	return keys
+}
+
+// simulationModules returns the modules of the module manager participating in
+// simulations, in registry order. A module listed in overrides is replaced by
+// the given instance, e.g. to provide simulation specific dependencies.
+func (r moduleRegistry) simulationModules(
+	mm *module.Manager,
+	overrides map[string]module.AppModuleSimulation,
+) []module.AppModuleSimulation {
+	var modules []module.AppModuleSimulation
+	for _, m := range r {
+		if !m.simulation {
+			continue
+		}
+
+		if override, ok := overrides[m.name]; ok {
+			modules = append(modules, override)
+			continue
+		}
+
+		simModule, ok := mm.Modules[m.name].(module.AppModuleSimulation)
+		if !ok {
+			panic(fmt.Errorf("module %s participates in simulations but does not implement AppModuleSimulation", m.name))
+		}
+		modules = append(modules, simModule)
+	}
+
+	return modules
+}
+
+// validate verifies that the registry is consistent with the module manager
+// it is used to build:
+//   - the registry and the module manager contain the same modules,
+//   - the ordering constraints and the legacy orders only refer to registered
+//     modules.
+func (r moduleRegistry) validate(mm *module.Manager) error {
+	registered := make(map[string]bool, len(r))
+	for _, m := range r {
+		if registered[m.name] {
+			return fmt.Errorf("module %s is registered more than once", m.name)
+		}
+		registered[m.name] = true
+
+		if _, ok := mm.Modules[m.name]; !ok {
+			return fmt.Errorf("module %s is registered but not added to the module manager", m.name)
+		}
+	}
+	for name := range mm.Modules {
+		if !registered[name] {
+			return fmt.Errorf("module %s is added to the module manager but not registered", name)
+		}
+	}
+
+	for _, phase := range orderPhases {
+		legacy := make(map[string]bool, len(phase.legacy))
+		for _, name := range phase.legacy {
+			if !registered[name] {
+				return fmt.Errorf("legacy %s order refers to unregistered module %s", phase.name, name)
+			}
+			if legacy[name] {
+				return fmt.Errorf("module %s appears more than once in the legacy %s order", name, phase.name)
+			}
+			legacy[name] = true
+		}
+		for _, m := range r {
+			c := phase.constraints(m)
+			for _, name := range append(append([]string{}, c.after...), c.before...) {
+				if !registered[name] {
+					return fmt.Errorf("%s order constraint of module %s refers to unregistered module %s", phase.name, m.name, name)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// mountedStoresLister is implemented by the multistores listing the stores
+// mounted on them, such as the rootmulti.Store.
+type mountedStoresLister interface {
+	StoreKeysByName() map[string]storetypes.StoreKey
+}
+
+// validateStores verifies that the stores mounted on the multistore match the
+// registry: every store key is owned by a single module and is mounted with
+// the type the module declares, and every mounted store is owned by a module.
+func (r moduleRegistry) validateStores(cms storetypes.CommitMultiStore) error {
+	lister, ok := cms.(mountedStoresLister)
+	if !ok {
+		return fmt.Errorf("multistore %T does not list its mounted stores", cms)
+	}
+	mounted := lister.StoreKeysByName()
+
+	owners := make(map[string]string)
+	for _, m := range r {
+		for _, key := range append(append(append([]string{}, m.kvStoreKeys...), m.transientStoreKeys...), m.memStoreKeys...) {
+			if owner, ok := owners[key]; ok {
+				return fmt.Errorf("store key %s is owned by both %s and %s", key, owner, m.name)
+			}
+			owners[key] = m.name
+		}
+
+		for _, key := range m.kvStoreKeys {
+			if _, ok := mounted[key].(*storetypes.KVStoreKey); !ok {
+				return fmt.Errorf("KV store %s of module %s is not mounted", key, m.name)
+			}
+		}
+		for _, key := range m.transientStoreKeys {
+			if _, ok := mounted[key].(*storetypes.TransientStoreKey); !ok {
+				return fmt.Errorf("transient store %s of module %s is not mounted", key, m.name)
+			}
+		}
+		for _, key := range m.memStoreKeys {
+			if _, ok := mounted[key].(*storetypes.MemoryStoreKey); !ok {
+				return fmt.Errorf("memory store %s of module %s is not mounted", key, m.name)
+			}
+		}
+	}
+	for key := range mounted {
+		if _, ok := owners[key]; !ok {
+			return fmt.Errorf("store %s is mounted but not owned by any module", key)
+		}
+	}
+
+	return nil
+}
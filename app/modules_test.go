@@ -0,0 +1,52 @@
+package gaia
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	recoverytypes "github.com/cosmos/gaia/v8/x/recovery/types"
+)
+
+// droppingMultiStore ignores the mounting of a store, standing for an app
+// which does not mount a store of its registry.
+type droppingMultiStore struct {
+	*rootmulti.Store
+
+	dropped string
+}
+
+func (s droppingMultiStore) MountStoreWithDB(key storetypes.StoreKey, typ storetypes.StoreType, db dbm.DB) {
+	if key.Name() == s.dropped {
+		return
+	}
+
+	s.Store.MountStoreWithDB(key, typ, db)
+}
+
+func newTestGaiaApp(db dbm.DB, baseAppOptions ...func(*baseapp.BaseApp)) *GaiaApp {
+	return NewGaiaApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), simapp.EmptyAppOptions{}, baseAppOptions...)
+}
+
+func TestNewGaiaAppValidatesMountedStores(t *testing.T) {
+	require.NotPanics(t, func() { newTestGaiaApp(dbm.NewMemDB()) })
+
+	db := dbm.NewMemDB()
+	require.PanicsWithError(t, "invalid module registry: KV store recovery of module recovery is not mounted", func() {
+		newTestGaiaApp(db, func(bApp *baseapp.BaseApp) {
+			bApp.SetCMS(droppingMultiStore{rootmulti.NewStore(db), recoverytypes.StoreKey})
+		})
+	})
+
+	require.PanicsWithError(t, "invalid module registry: store extra is mounted but not owned by any module", func() {
+		newTestGaiaApp(dbm.NewMemDB(), func(bApp *baseapp.BaseApp) {
+			bApp.MountStore(storetypes.NewKVStoreKey("extra"), storetypes.StoreTypeIAVL)
+		})
+	})
+}
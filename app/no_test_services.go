@@ -0,0 +1,10 @@
+//go:build !test_services
+// +build !test_services
+
+package gaia
+
+const testServicesEnabled = false
+
+// registerTestServices is a no-op in production builds. Build with the
+// test_services tag to register the SDK's test gRPC services.
+func registerTestServices(*GaiaApp) {}
@@ -1,23 +0,0 @@
-//go:build test_amino
-// +build test_amino
-
-package params
-
-import (
-	"github.com/cosmos/cosmos-sdk/codec"
-	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
-	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
-)
-
-func MakeTestEncodingConfig() EncodingConfig {
-	cdc := codec.NewLegacyAmino()
-	interfaceRegistry := cdctypes.NewInterfaceRegistry()
-	marshaler := codec.NewAminoCodec(cdc)
-
-	return EncodingConfig{
-		InterfaceRegistry: interfaceRegistry,
-		Marshaler:         marshaler,
-		TxConfig:          legacytx.StdTxConfig{Cdc: cdc},
-		Amino:             cdc,
-	}
-}
@@ -1,6 +1,8 @@
 package params
 
 import (
+	"time"
+
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 )
 
@@ -10,6 +12,14 @@ var (
 	// nolint: gosec
 	BypassMinFeeMsgTypesKey = "bypass-min-fee-msg-types"
 
+	// IBCHealthCheckIntervalKey defines the configuration key for the
+	// IBCHealth.CheckInterval value.
+	IBCHealthCheckIntervalKey = "ibc-health.check-interval"
+
+	// IBCHealthWarningThresholdsKey defines the configuration key for the
+	// IBCHealth.WarningThresholds value.
+	IBCHealthWarningThresholdsKey = "ibc-health.warning-thresholds"
+
 	// CustomConfigTemplate defines Gaia's custom application configuration TOML
 	// template. It extends the core SDK template.
 	CustomConfigTemplate = serverconfig.DefaultConfigTemplate + `
@@ -22,6 +32,102 @@ var (
 # Example:
 # ["/ibc.core.channel.v1.MsgRecvPacket", "/ibc.core.channel.v1.MsgAcknowledgement", ...]
 bypass-min-fee-msg-types = [{{ range .BypassMinFeeMsgTypes }}{{ printf "%q, " . }}{{end}}]
+
+[ibc-health]
+
+# check-interval is the number of blocks between two checks of the IBC light
+# clients, whose times to expiry and statuses are recorded as the
+# gaia_ibc_client_time_to_expiry_seconds and gaia_ibc_clients metrics. 0
+# disables the checks.
+check-interval = {{ .IBCHealth.CheckInterval }}
+
+# warning-thresholds lists the times to expiry below which a warning is logged
+# for a client, once per threshold crossed until the client is updated.
+#
+# Example:
+# ["72h", "24h", "6h", "1h"]
+warning-thresholds = [{{ range .IBCHealth.WarningThresholds }}{{ printf "%q, " . }}{{end}}]
+
+###############################################################################
+###                        Gaia Streaming Services                          ###
+###############################################################################
+
+[streaming.jsonl]
+
+# enable writes the decoded state changes of every block to JSONL files, one
+# set of rotating files per store. The files are read by the
+# "gaiad streaming tail" and "gaiad streaming replay" commands.
+enable = {{ .Streaming.JSONL.Enable }}
+
+# keys lists the store keys whose changes are written, "*" for every store.
+#
+# Example:
+# ["bank", "staking"]
+keys = [{{ range .Streaming.JSONL.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# dir is the directory of the files, defaults to data/streaming in the node
+# home directory.
+dir = "{{ .Streaming.JSONL.Dir }}"
+
+# max-file-size is the size, in megabytes, from which the file of a store is
+# rotated. Files are rotated between blocks, so a block never spans two files.
+max-file-size = {{ .Streaming.JSONL.MaxFileSize }}
+
+# max-files is the number of files kept per store, the oldest files being
+# deleted on rotation. 0 keeps every file.
+max-files = {{ .Streaming.JSONL.MaxFiles }}
+
+[streaming.liquidity]
+
+# enable records the price, reserves and volume of every liquidity pool at the
+# end of each executed batch into a node-local database, from which the
+# gaia.swap.v1beta1.History gRPC service serves OHLCV candles and TWAPs.
+enable = {{ .Streaming.Liquidity.Enable }}
+
+# data-dir is the directory of the database, defaults to the data directory of
+# the node.
+data-dir = "{{ .Streaming.Liquidity.DataDir }}"
+
+[streaming.txhistory]
+
+# enable records the txs involving each account, as sender, recipient,
+# delegator, IBC receiver or interchain account, into a node-local database,
+# from which the gaia.txhistory.v1beta1.Query gRPC service and the
+# "gaiad query account-txs" command serve the tx history of the accounts.
+enable = {{ .Streaming.TxHistory.Enable }}
+
+# data-dir is the directory of the database, defaults to the data directory of
+# the node.
+data-dir = "{{ .Streaming.TxHistory.DataDir }}"
+
+[streaming.events]
+
+# enable pushes the tx results and the BeginBlock and EndBlock events of every
+# block, as JSON, to the sinks. The events of a block are queued on disk at the
+# end of the block and removed once delivered, so every block is delivered at
+# least once to each sink, in order, even across restarts.
+enable = {{ .Streaming.Events.Enable }}
+
+# sinks lists the destinations of the events:
+#  - file:///path appends one block per line to a local file,
+#  - unix:///path writes one block per line to a Unix socket,
+#  - http://host/path or https://host/path POSTs each block to an endpoint,
+#    which must reply with a 2xx status code.
+#
+# Example:
+# ["file:///var/log/gaia/events.jsonl", "http://localhost:8080/blocks"]
+sinks = [{{ range .Streaming.Events.Sinks }}{{ printf "%q, " . }}{{end}}]
+
+# queue-dir is the directory of the on-disk queue, defaults to the data
+# directory of the node.
+queue-dir = "{{ .Streaming.Events.QueueDir }}"
+
+# timeout is the timeout of a delivery to a Unix socket or HTTP sink.
+timeout = "{{ .Streaming.Events.Timeout }}"
+
+# max-retry-interval is the maximum interval between the retries of a failed
+# delivery, the interval doubling from 1s after each failure.
+max-retry-interval = "{{ .Streaming.Events.MaxRetryInterval }}"
 `
 )
 
@@ -32,4 +138,96 @@ type CustomAppConfig struct {
 	// BypassMinFeeMsgTypes defines custom message types the operator may set that
 	// will bypass minimum fee checks during CheckTx.
 	BypassMinFeeMsgTypes []string `mapstructure:"bypass-min-fee-msg-types"`
+
+	// Streaming defines the configuration of the Gaia streaming services.
+	Streaming StreamingConfig `mapstructure:"streaming"`
+
+	// IBCHealth defines the configuration of the IBC client expiry watchdog.
+	IBCHealth IBCHealthConfig `mapstructure:"ibc-health"`
+}
+
+// IBCHealthConfig defines the configuration of the IBC client expiry
+// watchdog.
+type IBCHealthConfig struct {
+	// CheckInterval is the number of blocks between two checks, 0 disabling
+	// them.
+	CheckInterval uint64 `mapstructure:"check-interval"`
+
+	// WarningThresholds are the times to expiry below which a warning is
+	// logged.
+	WarningThresholds []time.Duration `mapstructure:"warning-thresholds"`
+}
+
+// DefaultIBCHealthConfig returns the default configuration of the IBC client
+// expiry watchdog, which checks the clients about every ten minutes.
+func DefaultIBCHealthConfig() IBCHealthConfig {
+	return IBCHealthConfig{
+		CheckInterval:     100,
+		WarningThresholds: []time.Duration{72 * time.Hour, 24 * time.Hour, 6 * time.Hour, time.Hour},
+	}
+}
+
+// StreamingConfig defines the configuration of the Gaia streaming services,
+// loaded along with the SDK streaming services listed in store.streamers.
+type StreamingConfig struct {
+	// JSONL configures the JSONL file streaming service.
+	JSONL JSONLStreamingConfig `mapstructure:"jsonl"`
+
+	// Liquidity configures the liquidity pool indexer.
+	Liquidity IndexerConfig `mapstructure:"liquidity"`
+
+	// TxHistory configures the tx history indexer.
+	TxHistory IndexerConfig `mapstructure:"txhistory"`
+
+	// Events configures the block events exporter.
+	Events EventExporterConfig `mapstructure:"events"`
+}
+
+// JSONLStreamingConfig defines the configuration of the JSONL file streaming
+// service.
+type JSONLStreamingConfig struct {
+	Enable bool     `mapstructure:"enable"`
+	Keys   []string `mapstructure:"keys"`
+	Dir    string   `mapstructure:"dir"`
+
+	// MaxFileSize is the size, in megabytes, from which a file is rotated.
+	MaxFileSize uint64 `mapstructure:"max-file-size"`
+
+	// MaxFiles is the number of files kept per store, 0 keeping every file.
+	MaxFiles uint64 `mapstructure:"max-files"`
+}
+
+// IndexerConfig defines the configuration of a node-local indexer.
+type IndexerConfig struct {
+	Enable  bool   `mapstructure:"enable"`
+	DataDir string `mapstructure:"data-dir"`
+}
+
+// EventExporterConfig defines the configuration of the block events exporter.
+type EventExporterConfig struct {
+	Enable   bool     `mapstructure:"enable"`
+	Sinks    []string `mapstructure:"sinks"`
+	QueueDir string   `mapstructure:"queue-dir"`
+
+	// Timeout is the timeout of a delivery to a Unix socket or HTTP sink.
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// MaxRetryInterval is the maximum interval between the retries of a
+	// failed delivery.
+	MaxRetryInterval time.Duration `mapstructure:"max-retry-interval"`
+}
+
+// DefaultStreamingConfig returns the default configuration of the Gaia
+// streaming services, which are all disabled.
+func DefaultStreamingConfig() StreamingConfig {
+	return StreamingConfig{
+		JSONL: JSONLStreamingConfig{
+			Keys:        []string{"*"},
+			MaxFileSize: 100,
+		},
+		Events: EventExporterConfig{
+			Timeout:          10 * time.Second,
+			MaxRetryInterval: time.Minute,
+		},
+	}
 }
@@ -3,17 +3,23 @@ package params
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
 
-// MakeEncodingConfig creates an EncodingConfig for an amino based test configuration.
-func MakeTestEncodingConfig() EncodingConfig {
+// MakeEncodingConfig creates the protobuf based EncodingConfig used by Gaia,
+// with the standard SDK interfaces and amino types registered. Module
+// interfaces are registered on top of it by the app.
+func MakeEncodingConfig() EncodingConfig {
 	amino := codec.NewLegacyAmino()
 	interfaceRegistry := types.NewInterfaceRegistry()
 	codec := codec.NewProtoCodec(interfaceRegistry)
 
 	txCfg := tx.NewTxConfig(codec, tx.DefaultSignModes)
 
+	std.RegisterLegacyAminoCodec(amino)
+	std.RegisterInterfaces(interfaceRegistry)
+
 	return EncodingConfig{
 		InterfaceRegistry: interfaceRegistry,
 		Codec:             codec,
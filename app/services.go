@@ -0,0 +1,102 @@
+package gaia
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+)
+
+// testServicePrefixes are the proto package prefixes of the services used by
+// the SDK for testing purposes, which must not be exposed in production.
+var testServicePrefixes = []string{"testdata.", "testpb."}
+
+// serviceRecorder wraps a gRPC service registrar and records the services
+// registered through it, so that the app can report the services it exposes.
+type serviceRecorder struct {
+	gogogrpc.Server
+
+	services []*grpc.ServiceDesc
+}
+
+func newServiceRecorder(srv gogogrpc.Server) *serviceRecorder {
+	return &serviceRecorder{Server: srv}
+}
+
+// RegisterService implements the gogogrpc.Server interface.
+func (r *serviceRecorder) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	r.services = append(r.services, sd)
+	r.Server.RegisterService(sd, handler)
+}
+
+func (r *serviceRecorder) serviceNames() []string {
+	names := make([]string, len(r.services))
+	for i, sd := range r.services {
+		names[i] = sd.ServiceName
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// QueryServices returns the gRPC query services registered by the app's
+// modules, in registration order.
+func (app *GaiaApp) QueryServices() []*grpc.ServiceDesc {
+	return app.queryServices.services
+}
+
+// MsgServices returns the Msg services registered by the app's modules, in
+// registration order.
+func (app *GaiaApp) MsgServices() []*grpc.ServiceDesc {
+	return app.msgServices.services
+}
+
+// MsgTypeURLs returns the sorted type URLs of all sdk.Msg implementations
+// registered in the app's interface registry.
+func (app *GaiaApp) MsgTypeURLs() []string {
+	typeURLs := app.interfaceRegistry.ListImplementations(sdk.MsgInterfaceProtoName)
+	sort.Strings(typeURLs)
+
+	return typeURLs
+}
+
+// auditServices logs the gRPC services and message types exposed by the app,
+// so that operators can audit its public surface, and verifies that the
+// surface only contains production services:
+//   - SDK test services are only registered when built with the test_services tag,
+//   - every registered sdk.Msg implementation is routed to a Msg service.
+func (app *GaiaApp) auditServices() error {
+	queryServices := app.queryServices.serviceNames()
+	msgServices := app.msgServices.serviceNames()
+	msgTypes := app.MsgTypeURLs()
+
+	logger := app.Logger().With("module", "services")
+	logger.Info("registered gRPC query services", "count", len(queryServices), "services", strings.Join(queryServices, ","))
+	logger.Info("registered Msg services", "count", len(msgServices), "services", strings.Join(msgServices, ","))
+	logger.Info("registered message types", "count", len(msgTypes), "types", strings.Join(msgTypes, ","))
+
+	if !testServicesEnabled {
+		for _, name := range append(queryServices, msgServices...) {
+			for _, prefix := range testServicePrefixes {
+				if strings.HasPrefix(name, prefix) {
+					return fmt.Errorf("test service %s is registered in a production build", name)
+				}
+			}
+		}
+	}
+
+	var unrouted []string
+	for _, typeURL := range msgTypes {
+		if app.msgSvcRouter.HandlerByTypeURL(typeURL) == nil {
+			unrouted = append(unrouted, typeURL)
+		}
+	}
+	if len(unrouted) > 0 {
+		return fmt.Errorf("registered message types have no Msg service handler: %s", strings.Join(unrouted, ", "))
+	}
+
+	return nil
+}
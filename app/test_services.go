@@ -0,0 +1,16 @@
+//go:build test_services
+// +build test_services
+
+package gaia
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata_pulsar"
+)
+
+const testServicesEnabled = true
+
+// registerTestServices adds the SDK's test gRPC service for testing gRPC
+// queries in isolation. It is only compiled with the test_services build tag.
+func registerTestServices(app *GaiaApp) {
+	testdata_pulsar.RegisterQueryServer(app.GRPCQueryRouter(), testdata_pulsar.QueryImpl{})
+}
@@ -0,0 +1,45 @@
+// Package docs generates and serves the OpenAPI specification of the gRPC
+// gateway routes exposed by Gaia's modules.
+//
+// The specification is generated from the query services registered by the
+// modules in ModuleBasics, so that it describes the routes actually served by
+// the node. Regenerate it with `make update-openapi-docs` after adding or
+// upgrading a module; `make check-openapi-docs` fails if a registered route is
+// missing from the embedded specification.
+package docs
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+)
+
+// OpenAPIFile is the path of the embedded specification, relative to the
+// repository root.
+const OpenAPIFile = "client/docs/openapi.json"
+
+//go:embed openapi.json
+var openAPISpec []byte
+
+// OpenAPISpec returns the raw embedded OpenAPI specification.
+func OpenAPISpec() []byte {
+	return openAPISpec
+}
+
+// LoadOpenAPI decodes the embedded OpenAPI specification.
+func LoadOpenAPI() (*OpenAPI, error) {
+	spec := &OpenAPI{}
+	if err := json.Unmarshal(openAPISpec, spec); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// Handler serves the embedded OpenAPI specification.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(openAPISpec)
+	})
+}
@@ -0,0 +1,489 @@
+package docs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Service identifies a gRPC service and the proto file defining it.
+type Service struct {
+	Name string
+	File string
+}
+
+// OpenAPI is a minimal Swagger 2.0 document, as served by the API server.
+type OpenAPI struct {
+	Swagger     string                           `json:"swagger"`
+	Info        Info                             `json:"info"`
+	Consumes    []string                         `json:"consumes"`
+	Produces    []string                         `json:"produces"`
+	Paths       map[string]map[string]*Operation `json:"paths"`
+	Definitions map[string]*Schema               `json:"definitions"`
+}
+
+// Info holds the title and version of an OpenAPI document.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+// Operation describes a single API operation on a path.
+type Operation struct {
+	Summary     string               `json:"summary"`
+	OperationID string               `json:"operationId"`
+	Tags        []string             `json:"tags"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter describes a single operation parameter.
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Type     string  `json:"type,omitempty"`
+	Format   string  `json:"format,omitempty"`
+	Schema   *Schema `json:"schema,omitempty"`
+}
+
+// Response describes a single response from an API operation.
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+// Schema describes the JSON representation of a proto message or field.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// Route is an HTTP method and path template exposed by the gRPC gateway.
+type Route struct {
+	Method string
+	Path   string
+}
+
+func (r Route) String() string {
+	return fmt.Sprintf("%s %s", strings.ToUpper(r.Method), r.Path)
+}
+
+var pathParamRegex = regexp.MustCompile(`{([^}=]+)(=[^}]*)?}`)
+
+// generator builds an OpenAPI document out of proto file descriptors.
+type generator struct {
+	files    map[string]*descriptorpb.FileDescriptorProto
+	messages map[string]*descriptorpb.DescriptorProto
+	enums    map[string]*descriptorpb.EnumDescriptorProto
+	spec     *OpenAPI
+}
+
+// Generate builds an OpenAPI document for all gRPC gateway routes declared by
+// the given services. Services that have no HTTP annotations are ignored.
+func Generate(title, version string, services []Service) (*OpenAPI, error) {
+	g := &generator{
+		files:    map[string]*descriptorpb.FileDescriptorProto{},
+		messages: map[string]*descriptorpb.DescriptorProto{},
+		enums:    map[string]*descriptorpb.EnumDescriptorProto{},
+		spec: &OpenAPI{
+			Swagger:     "2.0",
+			Info:        Info{Title: title, Description: "A REST interface for state queries and transactions", Version: version},
+			Consumes:    []string{"application/json"},
+			Produces:    []string{"application/json"},
+			Paths:       map[string]map[string]*Operation{},
+			Definitions: map[string]*Schema{},
+		},
+	}
+
+	for _, svc := range services {
+		if err := g.loadFile(svc.File); err != nil {
+			return nil, err
+		}
+	}
+
+	sorted := make([]Service, len(services))
+	copy(sorted, services)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	for _, svc := range sorted {
+		if err := g.addService(svc); err != nil {
+			return nil, err
+		}
+	}
+
+	return g.spec, nil
+}
+
+// Routes returns all gRPC gateway routes declared by the given services.
+func Routes(services []Service) ([]Route, error) {
+	spec, err := Generate("", "", services)
+	if err != nil {
+		return nil, err
+	}
+
+	return spec.Routes(), nil
+}
+
+// Routes returns all routes described by the OpenAPI document, sorted by path
+// and method.
+func (spec *OpenAPI) Routes() []Route {
+	var routes []Route
+	for path, item := range spec.Paths {
+		for method := range item {
+			routes = append(routes, Route{Method: method, Path: path})
+		}
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+
+	return routes
+}
+
+// MissingRoutes returns the routes that are not described by the OpenAPI
+// document.
+func (spec *OpenAPI) MissingRoutes(routes []Route) []Route {
+	var missing []Route
+	for _, route := range routes {
+		if _, ok := spec.Paths[route.Path][route.Method]; !ok {
+			missing = append(missing, route)
+		}
+	}
+
+	return missing
+}
+
+// loadFile loads the descriptor of a proto file and all of its dependencies,
+// indexing the declared messages and enums by fully-qualified name.
+func (g *generator) loadFile(name string) error {
+	if _, ok := g.files[name]; ok {
+		return nil
+	}
+
+	fd, err := fileDescriptor(name)
+	if err != nil {
+		return err
+	}
+	g.files[name] = fd
+
+	// dependencies that are not registered (e.g. option-only files such as
+	// gogoproto) do not declare any message used by the API and are skipped
+	for _, dep := range fd.GetDependency() {
+		if _, err := fileDescriptor(dep); err != nil {
+			continue
+		}
+		if err := g.loadFile(dep); err != nil {
+			return err
+		}
+	}
+
+	prefix := "." + fd.GetPackage()
+	for _, msg := range fd.GetMessageType() {
+		g.indexMessage(prefix, msg)
+	}
+	for _, enum := range fd.GetEnumType() {
+		g.enums[prefix+"."+enum.GetName()] = enum
+	}
+
+	return nil
+}
+
+func (g *generator) indexMessage(prefix string, msg *descriptorpb.DescriptorProto) {
+	name := prefix + "." + msg.GetName()
+	g.messages[name] = msg
+
+	for _, nested := range msg.GetNestedType() {
+		g.indexMessage(name, nested)
+	}
+	for _, enum := range msg.GetEnumType() {
+		g.enums[name+"."+enum.GetName()] = enum
+	}
+}
+
+func (g *generator) addService(svc Service) error {
+	fd := g.files[svc.File]
+
+	var sd *descriptorpb.ServiceDescriptorProto
+	for _, s := range fd.GetService() {
+		if fd.GetPackage()+"."+s.GetName() == svc.Name {
+			sd = s
+			break
+		}
+	}
+	if sd == nil {
+		return fmt.Errorf("service %s not found in %s", svc.Name, svc.File)
+	}
+
+	for _, method := range sd.GetMethod() {
+		if method.GetOptions() == nil || !proto.HasExtension(method.GetOptions(), annotations.E_Http) {
+			continue
+		}
+
+		rule, ok := proto.GetExtension(method.GetOptions(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
+
+		for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+			if err := g.addOperation(svc.Name, fd.GetPackage(), method, r); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *generator) addOperation(service, pkg string, method *descriptorpb.MethodDescriptorProto, rule *annotations.HttpRule) error {
+	var httpMethod, path string
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		httpMethod, path = "get", p.Get
+	case *annotations.HttpRule_Post:
+		httpMethod, path = "post", p.Post
+	case *annotations.HttpRule_Put:
+		httpMethod, path = "put", p.Put
+	case *annotations.HttpRule_Delete:
+		httpMethod, path = "delete", p.Delete
+	case *annotations.HttpRule_Patch:
+		httpMethod, path = "patch", p.Patch
+	default:
+		return fmt.Errorf("unsupported HTTP rule for %s.%s", service, method.GetName())
+	}
+
+	path = pathParamRegex.ReplaceAllString(path, "{$1}")
+
+	op := &Operation{
+		Summary:     method.GetName(),
+		OperationID: operationID(service, method.GetName()),
+		Tags:        []string{pkg},
+		Responses: map[string]*Response{
+			"200": {Description: "A successful response.", Schema: g.messageRef(method.GetOutputType())},
+			"default": {
+				Description: "An unexpected error response.",
+				Schema:      &Schema{Type: "object"},
+			},
+		},
+	}
+
+	pathParams := map[string]bool{}
+	for _, m := range pathParamRegex.FindAllStringSubmatch(path, -1) {
+		pathParams[m[1]] = true
+		op.Parameters = append(op.Parameters, &Parameter{Name: m[1], In: "path", Required: true, Type: "string"})
+	}
+
+	switch {
+	case rule.GetBody() == "*":
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name: "body", In: "body", Required: true, Schema: g.messageRef(method.GetInputType()),
+		})
+
+	case rule.GetBody() == "":
+		if input, ok := g.messages[method.GetInputType()]; ok {
+			op.Parameters = append(op.Parameters, g.queryParams("", input, pathParams, 0)...)
+		}
+	}
+
+	item, ok := g.spec.Paths[path]
+	if !ok {
+		item = map[string]*Operation{}
+		g.spec.Paths[path] = item
+	}
+	item[httpMethod] = op
+
+	return nil
+}
+
+// queryParams flattens the non-path fields of a request message into query
+// parameters, following the gRPC gateway conventions (e.g. pagination.limit).
+func (g *generator) queryParams(prefix string, msg *descriptorpb.DescriptorProto, pathParams map[string]bool, depth int) []*Parameter {
+	var params []*Parameter
+	for _, field := range msg.GetField() {
+		name := prefix + field.GetName()
+		if pathParams[name] {
+			continue
+		}
+
+		if field.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+			nested, ok := g.messages[field.GetTypeName()]
+			if ok && depth < 2 && field.GetLabel() != descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+				params = append(params, g.queryParams(name+".", nested, pathParams, depth+1)...)
+			}
+			continue
+		}
+
+		schema := g.fieldSchema(field)
+		param := &Parameter{Name: name, In: "query", Type: schema.Type, Format: schema.Format}
+		if field.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+			param.Type = "array"
+		}
+		params = append(params, param)
+	}
+
+	return params
+}
+
+// messageRef returns a reference to the definition of the given message,
+// adding the definition (and the ones it depends on) to the document.
+func (g *generator) messageRef(typeName string) *Schema {
+	if schema, ok := wellKnownSchema(typeName); ok {
+		return schema
+	}
+
+	name := strings.TrimPrefix(typeName, ".")
+	ref := &Schema{Ref: "#/definitions/" + name}
+	if _, ok := g.spec.Definitions[name]; ok {
+		return ref
+	}
+
+	msg, ok := g.messages[typeName]
+	if !ok {
+		return &Schema{Type: "object"}
+	}
+
+	def := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	// register the definition before resolving fields to allow recursive types
+	g.spec.Definitions[name] = def
+
+	for _, field := range msg.GetField() {
+		def.Properties[field.GetName()] = g.fieldSchema(field)
+	}
+
+	return ref
+}
+
+func (g *generator) fieldSchema(field *descriptorpb.FieldDescriptorProto) *Schema {
+	var schema *Schema
+
+	switch field.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:
+		if entry, ok := g.messages[field.GetTypeName()]; ok && entry.GetOptions().GetMapEntry() {
+			return &Schema{Type: "object", AdditionalProperties: g.fieldSchema(entry.GetField()[1])}
+		}
+		schema = g.messageRef(field.GetTypeName())
+
+	case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		schema = &Schema{Type: "string"}
+		if enum, ok := g.enums[field.GetTypeName()]; ok {
+			for _, v := range enum.GetValue() {
+				schema.Enum = append(schema.Enum, v.GetName())
+			}
+		}
+
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		schema = &Schema{Type: "boolean"}
+
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		schema = &Schema{Type: "string", Format: "byte"}
+
+	case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, descriptorpb.FieldDescriptorProto_TYPE_FLOAT:
+		schema = &Schema{Type: "number", Format: strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))}
+
+	case descriptorpb.FieldDescriptorProto_TYPE_INT32, descriptorpb.FieldDescriptorProto_TYPE_SINT32,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED32:
+		schema = &Schema{Type: "integer", Format: "int32"}
+
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT32, descriptorpb.FieldDescriptorProto_TYPE_FIXED32:
+		schema = &Schema{Type: "integer", Format: "int64"}
+
+	case descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+		// 64-bit integers are encoded as JSON strings
+		schema = &Schema{Type: "string", Format: "int64"}
+
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT64, descriptorpb.FieldDescriptorProto_TYPE_FIXED64:
+		schema = &Schema{Type: "string", Format: "uint64"}
+
+	default:
+		schema = &Schema{Type: "string"}
+	}
+
+	if field.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return &Schema{Type: "array", Items: schema}
+	}
+
+	return schema
+}
+
+// wellKnownSchema returns the JSON schema of protobuf well-known types, which
+// have a custom JSON representation.
+func wellKnownSchema(typeName string) (*Schema, bool) {
+	switch typeName {
+	case ".google.protobuf.Any":
+		return &Schema{
+			Type:                 "object",
+			Properties:           map[string]*Schema{"@type": {Type: "string"}},
+			AdditionalProperties: &Schema{},
+		}, true
+	case ".google.protobuf.Timestamp":
+		return &Schema{Type: "string", Format: "date-time"}, true
+	case ".google.protobuf.Duration":
+		return &Schema{Type: "string"}, true
+	default:
+		return nil, false
+	}
+}
+
+func operationID(service, method string) string {
+	parts := strings.Split(service, ".")
+	for i, p := range parts {
+		if p == "" {
+			continue
+		}
+		parts[i] = strings.ToUpper(p[:1]) + p[1:]
+	}
+
+	return strings.Join(parts, "") + method
+}
+
+// fileDescriptor returns the descriptor of a registered proto file. Gogoproto
+// files are looked up first, since all of the app's modules use gogoproto.
+func fileDescriptor(name string) (*descriptorpb.FileDescriptorProto, error) {
+	if gz := gogoproto.FileDescriptor(name); gz != nil {
+		zr, err := gzip.NewReader(bytes.NewReader(gz))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open descriptor of %s: %w", name, err)
+		}
+
+		bz, err := ioutil.ReadAll(zr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read descriptor of %s: %w", name, err)
+		}
+
+		fd := &descriptorpb.FileDescriptorProto{}
+		if err := proto.Unmarshal(bz, fd); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal descriptor of %s: %w", name, err)
+		}
+
+		return fd, nil
+	}
+
+	desc, err := protoregistry.GlobalFiles.FindFileByPath(name)
+	if err != nil {
+		return nil, fmt.Errorf("proto file %s is not registered: %w", name, err)
+	}
+
+	return protodesc.ToFileDescriptorProto(desc), nil
+}
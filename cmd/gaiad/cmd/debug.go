@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	gaia "github.com/cosmos/gaia/v8/app"
+	"github.com/cosmos/gaia/v8/app/params"
+)
+
+// debugCmd returns the SDK debug command extended with Gaia specific
+// subcommands.
+func debugCmd(encodingConfig params.EncodingConfig) *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(
+		servicesCmd(encodingConfig),
+		moduleOrderCmd(encodingConfig),
+	)
+
+	return cmd
+}
+
+func servicesCmd(encodingConfig params.EncodingConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "services",
+		Short: "List the gRPC query services, Msg services and message types registered by the app",
+		Long: `List the gRPC query services, Msg services and message types registered by
+the app, in order to audit the public surface exposed by the node. The same
+list is logged by the node on startup.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withInMemoryApp(encodingConfig, func(app *gaia.GaiaApp) error {
+				out := cmd.OutOrStdout()
+				printList(out, "Query services", serviceNames(app.QueryServices()))
+				printList(out, "Msg services", serviceNames(app.MsgServices()))
+				printList(out, "Message types", app.MsgTypeURLs())
+
+				return nil
+			})
+		},
+	}
+}
+
+func serviceNames(services []*grpc.ServiceDesc) []string {
+	names := make([]string, len(services))
+	for i, sd := range services {
+		names[i] = sd.ServiceName
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+func printList(out io.Writer, title string, items []string) {
+	fmt.Fprintf(out, "%s (%d):\n", title, len(items))
+	for _, item := range items {
+		fmt.Fprintf(out, "  %s\n", item)
+	}
+}
+
+func moduleOrderCmd(encodingConfig params.EncodingConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "module-order",
+		Short: "Print the begin block, end block and init genesis orders of the app's modules",
+		Long: `Print the begin block, end block and init genesis orders of the app's modules,
+as computed from the ordering constraints declared by the modules.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withInMemoryApp(encodingConfig, func(app *gaia.GaiaApp) error {
+				orders := app.ModuleOrders()
+
+				out := cmd.OutOrStdout()
+				printOrder(out, "Begin blockers", orders.BeginBlockers)
+				printOrder(out, "End blockers", orders.EndBlockers)
+				printOrder(out, "Init genesis", orders.InitGenesis)
+
+				return nil
+			})
+		},
+	}
+}
+
+func printOrder(out io.Writer, title string, order []string) {
+	fmt.Fprintf(out, "%s:\n", title)
+	for i, name := range order {
+		fmt.Fprintf(out, "  %2d. %s\n", i+1, name)
+	}
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	gaia "github.com/cosmos/gaia/v8/app"
+	"github.com/cosmos/gaia/v8/app/params"
+	"github.com/cosmos/gaia/v8/client/docs"
+)
+
+// apiServices lists the services whose gRPC gateway routes are registered by
+// the API server itself rather than by a module.
+var apiServices = []docs.Service{
+	{Name: "cosmos.tx.v1beta1.Service", File: "cosmos/tx/v1beta1/service.proto"},
+	{Name: "cosmos.base.tendermint.v1beta1.Service", File: "cosmos/base/tendermint/v1beta1/query.proto"},
+}
+
+// docsCmd returns the commands used to generate and verify the OpenAPI
+// specification served by the API server.
+func docsCmd(encodingConfig params.EncodingConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "docs",
+		Short: "Generate and verify the OpenAPI specification of the REST API",
+	}
+
+	cmd.AddCommand(
+		openAPIGenerateCmd(encodingConfig),
+		openAPICheckCmd(encodingConfig),
+	)
+
+	return cmd
+}
+
+func openAPIGenerateCmd(encodingConfig params.EncodingConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "generate [output-file]",
+		Short: "Generate the OpenAPI specification from the app's registered modules",
+		Long: fmt.Sprintf(`Generate the OpenAPI specification describing the gRPC gateway routes of
+every module registered in the app. If no output file is given, the
+specification is printed to stdout.
+
+Example:
+$ %s docs generate %s
+`, version.AppName, docs.OpenAPIFile),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			services, err := appServices(encodingConfig)
+			if err != nil {
+				return err
+			}
+
+			spec, err := docs.Generate("Gaia - gRPC Gateway docs", version.Version, services)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(spec, "", "  ")
+			if err != nil {
+				return err
+			}
+			bz = append(bz, '\n')
+
+			if len(args) == 0 {
+				_, err = cmd.OutOrStdout().Write(bz)
+				return err
+			}
+
+			return ioutil.WriteFile(args[0], bz, 0o600)
+		},
+	}
+}
+
+func openAPICheckCmd(encodingConfig params.EncodingConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "check",
+		Short: "Verify that every registered gRPC gateway route is described by the embedded OpenAPI specification",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			services, err := appServices(encodingConfig)
+			if err != nil {
+				return err
+			}
+
+			routes, err := docs.Routes(services)
+			if err != nil {
+				return err
+			}
+
+			spec, err := docs.LoadOpenAPI()
+			if err != nil {
+				return fmt.Errorf("failed to load embedded OpenAPI specification: %w", err)
+			}
+
+			missing := spec.MissingRoutes(routes)
+			if len(missing) > 0 {
+				lines := make([]string, len(missing))
+				for i, r := range missing {
+					lines[i] = "  " + r.String()
+				}
+
+				return fmt.Errorf(
+					"%d registered routes are missing from %s, run `make update-openapi-docs`:\n%s",
+					len(missing), docs.OpenAPIFile, strings.Join(lines, "\n"),
+				)
+			}
+
+			cmd.Printf("all %d registered routes are described by the OpenAPI specification\n", len(routes))
+			return nil
+		},
+	}
+}
+
+// appServices instantiates an in-memory app and returns the services whose
+// gRPC gateway routes are served by the API server.
+func appServices(encodingConfig params.EncodingConfig) ([]docs.Service, error) {
+	var services []docs.Service
+	err := withInMemoryApp(encodingConfig, func(app *gaia.GaiaApp) error {
+		services = append(services, apiServices...)
+		for _, sd := range app.QueryServices() {
+			file, ok := sd.Metadata.(string)
+			if !ok {
+				return fmt.Errorf("service %s does not declare its proto file", sd.ServiceName)
+			}
+
+			services = append(services, docs.Service{Name: sd.ServiceName, File: file})
+		}
+
+		return nil
+	})
+
+	return services, err
+}
+
+// withInMemoryApp instantiates an app backed by an in-memory database and a
+// temporary home directory and calls fn with it.
+func withInMemoryApp(encodingConfig params.EncodingConfig, fn func(app *gaia.GaiaApp) error) error {
+	home, err := ioutil.TempDir("", "gaia-app")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(home)
+
+	app := gaia.NewGaiaApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, false, map[int64]bool{},
+		home, 0, encodingConfig, simapp.EmptyAppOptions{},
+	)
+
+	return fn(app)
+}
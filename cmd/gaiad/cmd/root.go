@@ -9,7 +9,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
-	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
@@ -37,12 +36,17 @@ import (
 
 	gaia "github.com/cosmos/gaia/v8/app"
 	"github.com/cosmos/gaia/v8/app/params"
+	aprcli "github.com/cosmos/gaia/v8/x/apr/client/cli"
+	ibchealthcli "github.com/cosmos/gaia/v8/x/ibchealth/client/cli"
+	portfoliocli "github.com/cosmos/gaia/v8/x/portfolio/client/cli"
+	swapcli "github.com/cosmos/gaia/v8/x/swap/client/cli"
+	txhistorycli "github.com/cosmos/gaia/v8/x/txhistory/client/cli"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
 // main function.
 func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
-	encodingConfig := gaia.MakeTestEncodingConfig()
+	encodingConfig := gaia.MakeEncodingConfig()
 	initClientCtx := client.Context{}.
 		WithCodec(encodingConfig.Codec).
 		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
@@ -100,7 +104,9 @@ func initAppConfig() (string, interface{}) {
 	srvCfg.StateSync.SnapshotKeepRecent = 10
 
 	return params.CustomConfigTemplate, params.CustomAppConfig{
-		Config: *srvCfg,
+		Config:    *srvCfg,
+		Streaming: params.DefaultStreamingConfig(),
+		IBCHealth: params.DefaultIBCHealthConfig(),
 		// BypassMinFeeMsgTypes: []string{
 		// 	sdk.MsgTypeURL(&ibcchanneltypes.MsgRecvPacket{}),
 		// 	sdk.MsgTypeURL(&ibcchanneltypes.MsgAcknowledgement{}),
@@ -122,8 +128,10 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		AddGenesisAccountCmd(gaia.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(gaia.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCmd(encodingConfig),
 		config.Cmd(),
+		docsCmd(encodingConfig),
+		streamingCmd(),
 	)
 
 	ac := appCreator{
@@ -163,6 +171,11 @@ func queryCommand() *cobra.Command {
 		rpc.BlockCommand(),
 		authcmd.QueryTxsByEventsCmd(),
 		authcmd.QueryTxCmd(),
+		swapcli.GetQueryCmd(),
+		portfoliocli.GetQueryCmd(),
+		txhistorycli.GetCmdQueryAccountTxs(),
+		aprcli.GetCmdQueryApr(),
+		ibchealthcli.GetQueryCmd(),
 	)
 
 	gaia.ModuleBasics.AddQueryCommands(cmd)
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/streaming"
+)
+
+const (
+	flagStreamingDir = "dir"
+	flagStores       = "stores"
+	flagLines        = "lines"
+	flagFollow       = "follow"
+	flagFromHeight   = "from-height"
+	flagToHeight     = "to-height"
+)
+
+// streamingCmd returns the commands reading the state changes written by the
+// JSONL streaming service.
+func streamingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "streaming",
+		Short: "Read the state changes written by the JSONL streaming service",
+		Long: `Read the state changes written by the JSONL streaming service, enabled by the
+[streaming.jsonl] section of app.toml. Changes are printed as JSON lines.`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		streamingTailCmd(),
+		streamingReplayCmd(),
+	)
+
+	cmd.PersistentFlags().String(flagStreamingDir, "", "Directory of the JSONL files (default data/streaming in the node home directory)")
+	cmd.PersistentFlags().StringSlice(flagStores, nil, "Store keys whose changes are read (default every store)")
+
+	return cmd
+}
+
+func streamingTailCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tail",
+		Short: "Print the last state changes and optionally follow the new ones",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dir, stores, err := streamingFiles(cmd)
+			if err != nil {
+				return err
+			}
+			lines, _ := cmd.Flags().GetInt(flagLines)
+			follow, _ := cmd.Flags().GetBool(flagFollow)
+
+			out := cmd.OutOrStdout()
+			if lines > 0 {
+				// keep the last changes of the written blocks
+				var last []streaming.KVChange
+				err := streaming.Replay(dir, stores, 0, 0, func(change streaming.KVChange) error {
+					last = append(last, change)
+					if len(last) > lines {
+						last = last[1:]
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				for _, change := range last {
+					if err := printChange(out, change); err != nil {
+						return err
+					}
+				}
+			}
+			if !follow {
+				return nil
+			}
+
+			return streaming.Follow(cmd.Context(), dir, stores, time.Second, func(change streaming.KVChange) error {
+				return printChange(out, change)
+			})
+		},
+	}
+
+	cmd.Flags().IntP(flagLines, "n", 10, "Number of last changes to print")
+	cmd.Flags().BoolP(flagFollow, "f", false, "Print the changes as they are written")
+
+	return cmd
+}
+
+func streamingReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Print the state changes of a height range in order",
+		Long: `Print the state changes of a height range, ordered by height, then by store,
+keeping the order in which the changes of a store were written.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dir, stores, err := streamingFiles(cmd)
+			if err != nil {
+				return err
+			}
+			fromHeight, _ := cmd.Flags().GetInt64(flagFromHeight)
+			toHeight, _ := cmd.Flags().GetInt64(flagToHeight)
+			if toHeight > 0 && toHeight < fromHeight {
+				return fmt.Errorf("--%s must not be lower than --%s", flagToHeight, flagFromHeight)
+			}
+
+			out := cmd.OutOrStdout()
+			return streaming.Replay(dir, stores, fromHeight, toHeight, func(change streaming.KVChange) error {
+				return printChange(out, change)
+			})
+		},
+	}
+
+	cmd.Flags().Int64(flagFromHeight, 0, "First height of the range")
+	cmd.Flags().Int64(flagToHeight, 0, "Last height of the range (default the last written height)")
+
+	return cmd
+}
+
+// streamingFiles returns the directory of the JSONL files and the stores to
+// read, every store with files by default.
+func streamingFiles(cmd *cobra.Command) (string, []string, error) {
+	dir, _ := cmd.Flags().GetString(flagStreamingDir)
+	if dir == "" {
+		dir = filepath.Join(client.GetClientContextFromCmd(cmd).HomeDir, "data", "streaming")
+	}
+
+	stores, _ := cmd.Flags().GetStringSlice(flagStores)
+	if len(stores) == 0 {
+		var err error
+		if stores, err = streaming.Stores(dir); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return dir, stores, nil
+}
+
+func printChange(out io.Writer, change streaming.KVChange) error {
+	bz, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(out, "%s\n", bz)
+	return err
+}
@@ -128,7 +128,8 @@ func InitTestnet(
 	valPubKeys := make([]cryptotypes.PubKey, numValidators)
 
 	simappConfig := params.CustomAppConfig{
-		Config: *srvconfig.DefaultConfig(),
+		Config:    *srvconfig.DefaultConfig(),
+		Streaming: params.DefaultStreamingConfig(),
 	}
 	simappConfig.MinGasPrices = minGasPrices
 	simappConfig.API.Enable = true
@@ -0,0 +1,131 @@
+package streaming
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// valueDecoder decodes the value of a KV pair to JSON.
+type valueDecoder func(cdc codec.Codec, key, value []byte) (json.RawMessage, error)
+
+// prefixDecoder decodes the values of the keys with the given prefix.
+type prefixDecoder struct {
+	prefix []byte
+	decode valueDecoder
+}
+
+// storeDecoders lists the decoders of the values of each store. The values of
+// the keys without a decoder are written as raw bytes.
+var storeDecoders = map[string][]prefixDecoder{
+	authtypes.StoreKey: {
+		{authtypes.AddressStoreKeyPrefix, decodeAccount},
+	},
+	banktypes.StoreKey: {
+		{banktypes.SupplyKey, decodeSupply},
+		{banktypes.DenomMetadataPrefix, protoDecoder(func() codec.ProtoMarshaler { return &banktypes.Metadata{} })},
+		{banktypes.BalancesPrefix, decodeBalance},
+	},
+	stakingtypes.StoreKey: {
+		{stakingtypes.ValidatorsKey, protoDecoder(func() codec.ProtoMarshaler { return &stakingtypes.Validator{} })},
+		{stakingtypes.DelegationKey, protoDecoder(func() codec.ProtoMarshaler { return &stakingtypes.Delegation{} })},
+		{stakingtypes.UnbondingDelegationKey, protoDecoder(func() codec.ProtoMarshaler { return &stakingtypes.UnbondingDelegation{} })},
+		{stakingtypes.RedelegationKey, protoDecoder(func() codec.ProtoMarshaler { return &stakingtypes.Redelegation{} })},
+		{stakingtypes.HistoricalInfoKey, protoDecoder(func() codec.ProtoMarshaler { return &stakingtypes.HistoricalInfo{} })},
+	},
+	distrtypes.StoreKey: {
+		{distrtypes.FeePoolKey, protoDecoder(func() codec.ProtoMarshaler { return &distrtypes.FeePool{} })},
+		{distrtypes.ValidatorOutstandingRewardsPrefix, protoDecoder(func() codec.ProtoMarshaler { return &distrtypes.ValidatorOutstandingRewards{} })},
+		{distrtypes.DelegatorStartingInfoPrefix, protoDecoder(func() codec.ProtoMarshaler { return &distrtypes.DelegatorStartingInfo{} })},
+		{distrtypes.ValidatorHistoricalRewardsPrefix, protoDecoder(func() codec.ProtoMarshaler { return &distrtypes.ValidatorHistoricalRewards{} })},
+		{distrtypes.ValidatorCurrentRewardsPrefix, protoDecoder(func() codec.ProtoMarshaler { return &distrtypes.ValidatorCurrentRewards{} })},
+		{distrtypes.ValidatorAccumulatedCommissionPrefix, protoDecoder(func() codec.ProtoMarshaler { return &distrtypes.ValidatorAccumulatedCommission{} })},
+		{distrtypes.ValidatorSlashEventPrefix, protoDecoder(func() codec.ProtoMarshaler { return &distrtypes.ValidatorSlashEvent{} })},
+	},
+	govtypes.StoreKey: {
+		{govtypes.ProposalsKeyPrefix, protoDecoder(func() codec.ProtoMarshaler { return &govv1.Proposal{} })},
+		{govtypes.DepositsKeyPrefix, protoDecoder(func() codec.ProtoMarshaler { return &govv1.Deposit{} })},
+		{govtypes.VotesKeyPrefix, protoDecoder(func() codec.ProtoMarshaler { return &govv1.Vote{} })},
+	},
+}
+
+// decodeValue returns the JSON encoding of the value of a KV pair of the
+// store. It returns false if the key has no decoder or the value cannot be
+// decoded.
+func decodeValue(cdc codec.Codec, storeKey string, key, value []byte) (json.RawMessage, bool) {
+	for _, decoder := range storeDecoders[storeKey] {
+		if !bytes.HasPrefix(key, decoder.prefix) {
+			continue
+		}
+
+		bz, err := decoder.decode(cdc, key, value)
+		if err != nil {
+			return nil, false
+		}
+
+		return bz, true
+	}
+
+	return nil, false
+}
+
+// protoDecoder returns a decoder of protobuf messages of the type returned by
+// newMsg.
+func protoDecoder(newMsg func() codec.ProtoMarshaler) valueDecoder {
+	return func(cdc codec.Codec, _, value []byte) (json.RawMessage, error) {
+		msg := newMsg()
+		if err := cdc.Unmarshal(value, msg); err != nil {
+			return nil, err
+		}
+
+		return cdc.MarshalJSON(msg)
+	}
+}
+
+// decodeAccount decodes an account, which is stored as an Any.
+func decodeAccount(cdc codec.Codec, _, value []byte) (json.RawMessage, error) {
+	var account authtypes.AccountI
+	if err := cdc.UnmarshalInterface(value, &account); err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalInterfaceJSON(account)
+}
+
+// decodeSupply decodes the supply of a denom, whose amount is stored under
+// the denom.
+func decodeSupply(_ codec.Codec, key, value []byte) (json.RawMessage, error) {
+	var amount sdk.Int
+	if err := amount.Unmarshal(value); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(sdk.Coin{Denom: string(key[len(banktypes.SupplyKey):]), Amount: amount})
+}
+
+// decodeBalance decodes the balance of an account in a denom, whose amount is
+// stored under the address and the denom.
+func decodeBalance(_ codec.Codec, key, value []byte) (json.RawMessage, error) {
+	addr, denom, err := banktypes.AddressAndDenomFromBalancesStore(key[len(banktypes.BalancesPrefix):])
+	if err != nil {
+		return nil, err
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(value); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		Address string   `json:"address"`
+		Coin    sdk.Coin `json:"coin"`
+	}{addr.String(), sdk.Coin{Denom: denom, Amount: amount}})
+}
@@ -0,0 +1,289 @@
+package streaming
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	// EventsServiceName is the name of the block events exporter streaming
+	// service, configured in the streaming.events section of the application
+	// configuration.
+	EventsServiceName = "events"
+
+	// eventQueueDBName is the name of the database of the event queue.
+	eventQueueDBName = "events_queue"
+
+	defaultSinkTimeout      = 10 * time.Second
+	defaultMaxRetryInterval = time.Minute
+	minRetryInterval        = time.Second
+)
+
+// BlockEvents are the tx results and the BeginBlock and EndBlock events of a
+// block, exported as JSON.
+type BlockEvents struct {
+	ChainID          string       `json:"chain_id"`
+	Height           int64        `json:"height"`
+	Time             time.Time    `json:"time"`
+	BeginBlockEvents []abci.Event `json:"begin_block_events"`
+	TxResults        []TxResult   `json:"tx_results"`
+	EndBlockEvents   []abci.Event `json:"end_block_events"`
+}
+
+// TxResult is the result of a tx of a block.
+type TxResult struct {
+	Index     int          `json:"index"`
+	Hash      string       `json:"hash"`
+	Code      uint32       `json:"code"`
+	Codespace string       `json:"codespace,omitempty"`
+	Log       string       `json:"log,omitempty"`
+	GasWanted int64        `json:"gas_wanted"`
+	GasUsed   int64        `json:"gas_used"`
+	Events    []abci.Event `json:"events"`
+}
+
+var _ baseapp.StreamingService = (*EventExporter)(nil)
+
+// EventExporter is a streaming service pushing the events of every block to
+// sinks. The events of a block are written to an on-disk queue at the end of
+// the block and removed from it once delivered, so every block is delivered
+// at least once to each sink, in order, even across restarts. Delivery
+// failures are retried with an exponential backoff.
+type EventExporter struct {
+	logger log.Logger
+
+	db               dbm.DB
+	queue            *eventQueue
+	sinkNames        []string
+	sinks            map[string]eventSink
+	maxRetryInterval time.Duration
+
+	// block holds the events of the current block.
+	block *BlockEvents
+
+	wake      map[string]chan struct{}
+	quit      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// NewEventExporter is the ServiceConstructor of the block events exporter.
+func NewEventExporter(opts servertypes.AppOptions, _ []storetypes.StoreKey, _ codec.Codec, logger log.Logger) (baseapp.StreamingService, error) {
+	dir := cast.ToString(opts.Get("streaming.events.queue-dir"))
+	if dir == "" {
+		dir = filepath.Join(cast.ToString(opts.Get(flags.FlagHome)), "data")
+	}
+	timeout := cast.ToDuration(opts.Get("streaming.events.timeout"))
+	if timeout <= 0 {
+		timeout = defaultSinkTimeout
+	}
+	maxRetryInterval := cast.ToDuration(opts.Get("streaming.events.max-retry-interval"))
+	if maxRetryInterval < minRetryInterval {
+		maxRetryInterval = defaultMaxRetryInterval
+	}
+
+	db, err := dbm.NewDB(eventQueueDBName, server.GetAppDBBackend(opts), dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event queue database: %w", err)
+	}
+
+	exporter, err := newEventExporter(db, cast.ToStringSlice(opts.Get("streaming.events.sinks")), timeout, maxRetryInterval, logger)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return exporter, nil
+}
+
+func newEventExporter(db dbm.DB, sinkURLs []string, timeout, maxRetryInterval time.Duration, logger log.Logger) (*EventExporter, error) {
+	if len(sinkURLs) == 0 {
+		return nil, fmt.Errorf("no event sink configured")
+	}
+
+	e := &EventExporter{
+		logger:           logger,
+		db:               db,
+		sinks:            make(map[string]eventSink, len(sinkURLs)),
+		maxRetryInterval: maxRetryInterval,
+		wake:             make(map[string]chan struct{}, len(sinkURLs)),
+		quit:             make(chan struct{}),
+	}
+	for _, sinkURL := range sinkURLs {
+		if _, ok := e.sinks[sinkURL]; ok {
+			return nil, fmt.Errorf("duplicate event sink %q", sinkURL)
+		}
+
+		sink, err := newEventSink(sinkURL, timeout)
+		if err != nil {
+			return nil, err
+		}
+		e.sinkNames = append(e.sinkNames, sinkURL)
+		e.sinks[sinkURL] = sink
+		e.wake[sinkURL] = make(chan struct{}, 1)
+	}
+
+	queue, err := newEventQueue(db, e.sinkNames)
+	if err != nil {
+		return nil, err
+	}
+	e.queue = queue
+
+	return e, nil
+}
+
+// Stream implements baseapp.StreamingService, starting the delivery loop of
+// each sink.
+func (e *EventExporter) Stream(wg *sync.WaitGroup) error {
+	for _, name := range e.sinkNames {
+		wg.Add(1)
+		e.wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			defer e.wg.Done()
+			e.deliver(name)
+		}(name)
+	}
+
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService.
+func (e *EventExporter) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (e *EventExporter) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	e.block = &BlockEvents{
+		ChainID:          req.Header.ChainID,
+		Height:           req.Header.Height,
+		Time:             req.Header.Time,
+		BeginBlockEvents: res.Events,
+		TxResults:        []TxResult{},
+	}
+
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (e *EventExporter) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if e.block == nil {
+		return nil
+	}
+
+	e.block.TxResults = append(e.block.TxResults, TxResult{
+		Index:     len(e.block.TxResults),
+		Hash:      fmt.Sprintf("%X", tmtypes.Tx(req.Tx).Hash()),
+		Code:      res.Code,
+		Codespace: res.Codespace,
+		Log:       res.Log,
+		GasWanted: res.GasWanted,
+		GasUsed:   res.GasUsed,
+		Events:    res.Events,
+	})
+
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener, queuing the events of the
+// block for every sink.
+func (e *EventExporter) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	if e.block == nil {
+		return nil
+	}
+	block := e.block
+	e.block = nil
+	block.EndBlockEvents = res.Events
+
+	data, err := json.Marshal(block)
+	if err != nil {
+		return err
+	}
+	if err := e.queue.push(e.sinkNames, data); err != nil {
+		return fmt.Errorf("failed to queue the events of block %d: %w", block.Height, err)
+	}
+
+	for _, wake := range e.wake {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// Close implements io.Closer, stopping the delivery loops. Queued events are
+// delivered once the node restarts.
+func (e *EventExporter) Close() error {
+	var err error
+	e.closeOnce.Do(func() {
+		close(e.quit)
+		e.wg.Wait()
+
+		for _, name := range e.sinkNames {
+			if closeErr := e.sinks[name].close(); err == nil {
+				err = closeErr
+			}
+		}
+		if closeErr := e.db.Close(); err == nil {
+			err = closeErr
+		}
+	})
+
+	return err
+}
+
+// deliver delivers the queued events of a sink until the exporter is closed.
+func (e *EventExporter) deliver(name string) {
+	sink := e.sinks[name]
+	retryInterval := minRetryInterval
+
+	for {
+		seq, data, ok, err := e.queue.peek(name)
+		if err == nil && !ok {
+			select {
+			case <-e.wake[name]:
+				continue
+			case <-e.quit:
+				return
+			}
+		}
+		if err == nil {
+			if err = sink.send(data); err == nil {
+				err = e.queue.remove(name, seq)
+			}
+		}
+		if err == nil {
+			retryInterval = minRetryInterval
+			continue
+		}
+
+		e.logger.Error("failed to deliver block events", "sink", name, "retry_in", retryInterval, "err", err)
+		select {
+		case <-time.After(retryInterval):
+		case <-e.quit:
+			return
+		}
+		if retryInterval *= 2; retryInterval > e.maxRetryInterval {
+			retryInterval = e.maxRetryInterval
+		}
+	}
+}
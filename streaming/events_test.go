@@ -0,0 +1,120 @@
+package streaming
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestEventExporter(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "events.jsonl")
+
+	// the HTTP endpoint fails the first delivery
+	var (
+		mu       sync.Mutex
+		failures = 1
+		received []BlockEvents
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var block BlockEvents
+		bz, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bz, &block))
+		received = append(received, block)
+	}))
+	defer server.Close()
+
+	sinks := []string{"file://" + filePath, server.URL}
+	newExporter := func() *EventExporter {
+		db, err := dbm.NewDB(eventQueueDBName, dbm.GoLevelDBBackend, dir)
+		require.NoError(t, err)
+		e, err := newEventExporter(db, sinks, time.Second, time.Second, log.NewNopLogger())
+		require.NoError(t, err)
+		return e
+	}
+	endBlock := func(e *EventExporter, height int64) {
+		header := tmproto.Header{ChainID: "test", Height: height, Time: time.Unix(1_650_000_000+height, 0).UTC()}
+		event := abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "amount", Value: "1uatom"}}}
+
+		require.NoError(t, e.ListenBeginBlock(sdk.Context{}, abci.RequestBeginBlock{Header: header}, abci.ResponseBeginBlock{Events: []abci.Event{event}}))
+		require.NoError(t, e.ListenDeliverTx(sdk.Context{}, abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{Code: 5, Events: []abci.Event{event}}))
+		require.NoError(t, e.ListenEndBlock(sdk.Context{}, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+	}
+
+	// the events queued before a restart are delivered after it
+	e := newExporter()
+	endBlock(e, 1)
+	endBlock(e, 2)
+	require.NoError(t, e.Close())
+
+	e = newExporter()
+	var wg sync.WaitGroup
+	require.NoError(t, e.Stream(&wg))
+	endBlock(e, 3)
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == 3
+	}, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, e.Close())
+	wg.Wait()
+
+	for i, block := range received {
+		require.Equal(t, int64(i+1), block.Height)
+		require.Equal(t, "test", block.ChainID)
+		require.Len(t, block.BeginBlockEvents, 1)
+		require.Len(t, block.TxResults, 1)
+		require.Equal(t, uint32(5), block.TxResults[0].Code)
+		require.Len(t, block.TxResults[0].Hash, 64)
+	}
+
+	file, err := os.Open(filePath)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var heights []int64
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var block BlockEvents
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &block))
+		heights = append(heights, block.Height)
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, []int64{1, 2, 3}, heights)
+
+	// the delivered events are removed from the queue
+	db, err := dbm.NewDB(eventQueueDBName, dbm.GoLevelDBBackend, dir)
+	require.NoError(t, err)
+	defer db.Close()
+	queue, err := newEventQueue(db, sinks)
+	require.NoError(t, err)
+	for _, sink := range sinks {
+		_, _, ok, err := queue.peek(sink)
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+}
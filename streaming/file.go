@@ -0,0 +1,147 @@
+package streaming
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// fileExt is the extension of the JSONL files.
+const fileExt = ".jsonl"
+
+// fileName returns the name of a JSONL file starting at the given height. The
+// heights are zero-padded, so the files sort chronologically by name.
+func fileName(height int64) string {
+	return fmt.Sprintf("%012d%s", height, fileExt)
+}
+
+// fileHeight returns the height a JSONL file starts at.
+func fileHeight(name string) (int64, error) {
+	return strconv.ParseInt(strings.TrimSuffix(filepath.Base(name), fileExt), 10, 64)
+}
+
+// storeFiles returns the paths of the JSONL files of a store directory, in
+// chronological order.
+func storeFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != fileExt {
+			continue
+		}
+		if _, err := fileHeight(entry.Name()); err != nil {
+			continue
+		}
+		files = append(files, filepath.Join(dir, entry.Name()))
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// rotatingFile writes the changes of a store to JSONL files, starting a new
+// file once the current one reached its maximum size.
+type rotatingFile struct {
+	dir      string
+	maxSize  int64
+	maxFiles int
+
+	file *os.File
+	size int64
+}
+
+func newRotatingFile(dir string, maxSize int64, maxFiles int) *rotatingFile {
+	return &rotatingFile{
+		dir:      dir,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+	}
+}
+
+// write appends data to the current file, opening a file for the block at the
+// given height if there is none. The last file of a previous run is reused
+// until it reaches its maximum size, and the oldest files are deleted when a
+// new file is created.
+func (f *rotatingFile) write(height int64, data []byte) error {
+	if f.file == nil {
+		if err := f.open(height); err != nil {
+			return err
+		}
+	}
+
+	n, err := f.file.Write(data)
+	f.size += int64(n)
+
+	return err
+}
+
+func (f *rotatingFile) open(height int64) error {
+	if err := os.MkdirAll(f.dir, 0o755); err != nil {
+		return err
+	}
+
+	path := filepath.Join(f.dir, fileName(height))
+	files, err := storeFiles(f.dir)
+	if err != nil {
+		return err
+	}
+	reuse := false
+	if len(files) > 0 {
+		info, err := os.Stat(files[len(files)-1])
+		if err != nil {
+			return err
+		}
+		if reuse = info.Size() < f.maxSize; reuse {
+			path = files[len(files)-1]
+		}
+	}
+	// delete the oldest files so that the new file does not exceed the
+	// maximum number of files
+	for !reuse && f.maxFiles > 0 && len(files) >= f.maxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file, f.size = file, info.Size()
+
+	return nil
+}
+
+// endBlock closes the current file once it reached its maximum size, so the
+// next block starts a new file.
+func (f *rotatingFile) endBlock() error {
+	if f.file == nil || f.size < f.maxSize {
+		return nil
+	}
+
+	return f.close()
+}
+
+func (f *rotatingFile) close() error {
+	if f.file == nil {
+		return nil
+	}
+
+	err := f.file.Close()
+	f.file, f.size = nil, 0
+
+	return err
+}
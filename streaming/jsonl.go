@@ -0,0 +1,221 @@
+package streaming
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// JSONLServiceName is the name of the JSONL file streaming service,
+	// configured in the streaming.jsonl section of the application
+	// configuration.
+	JSONLServiceName = "jsonl"
+
+	// defaultMaxFileSize is the default size, in megabytes, from which a
+	// file is rotated.
+	defaultMaxFileSize = 100
+)
+
+// KVChange is a change of a KV pair of a store, written as a line of the JSONL
+// files. The value is decoded to JSON for the stores and key prefixes with a
+// known encoding, and is otherwise written as raw bytes.
+type KVChange struct {
+	Height   int64           `json:"height"`
+	Time     time.Time       `json:"time"`
+	StoreKey string          `json:"store_key"`
+	Key      string          `json:"key"`
+	Delete   bool            `json:"delete,omitempty"`
+	Value    json.RawMessage `json:"value,omitempty"`
+	RawValue []byte          `json:"raw_value,omitempty"`
+}
+
+var (
+	_ baseapp.StreamingService = (*JSONLStreamingService)(nil)
+	_ storetypes.WriteListener = (*JSONLStreamingService)(nil)
+)
+
+// JSONLStreamingService is a streaming service writing the decoded changes of
+// the exposed stores to rotating JSONL files, one set of files per store.
+//
+// The store changes of a block are only reported when the block is committed,
+// so the changes are buffered until the next block begins, or the service is
+// closed, and written with the height and time of the block they belong to.
+type JSONLStreamingService struct {
+	cdc       codec.Codec
+	listeners map[storetypes.StoreKey][]storetypes.WriteListener
+
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+
+	mu      sync.Mutex
+	files   map[string]*rotatingFile
+	height  int64
+	time    time.Time
+	pending []KVChange
+}
+
+// NewJSONLStreamingService is the ServiceConstructor of the JSONL file
+// streaming service.
+func NewJSONLStreamingService(opts servertypes.AppOptions, keys []storetypes.StoreKey, cdc codec.Codec, _ log.Logger) (baseapp.StreamingService, error) {
+	dir := cast.ToString(opts.Get("streaming.jsonl.dir"))
+	if dir == "" {
+		dir = filepath.Join(cast.ToString(opts.Get(flags.FlagHome)), "data", "streaming")
+	}
+	maxFileSize := cast.ToInt64(opts.Get("streaming.jsonl.max-file-size"))
+	if maxFileSize <= 0 {
+		maxFileSize = defaultMaxFileSize
+	}
+
+	return newJSONLStreamingService(dir, keys, cdc, maxFileSize<<20, cast.ToInt(opts.Get("streaming.jsonl.max-files")))
+}
+
+func newJSONLStreamingService(dir string, keys []storetypes.StoreKey, cdc codec.Codec, maxFileSize int64, maxFiles int) (*JSONLStreamingService, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no store key exposed")
+	}
+
+	s := &JSONLStreamingService{
+		cdc:         cdc,
+		listeners:   make(map[storetypes.StoreKey][]storetypes.WriteListener, len(keys)),
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+		files:       make(map[string]*rotatingFile, len(keys)),
+	}
+	for _, key := range keys {
+		s.listeners[key] = []storetypes.WriteListener{s}
+		s.files[key.Name()] = newRotatingFile(filepath.Join(dir, key.Name()), maxFileSize, maxFiles)
+	}
+
+	return s, nil
+}
+
+// Stream implements baseapp.StreamingService. Changes are written
+// synchronously, so there is no streaming loop.
+func (s *JSONLStreamingService) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService.
+func (s *JSONLStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return s.listeners
+}
+
+// OnWrite implements storetypes.WriteListener, buffering the change until the
+// block is known.
+func (s *JSONLStreamingService) OnWrite(storeKey storetypes.StoreKey, key, value []byte, delete bool) error {
+	change := KVChange{
+		StoreKey: storeKey.Name(),
+		Key:      fmt.Sprintf("%X", key),
+		Delete:   delete,
+	}
+	if !delete {
+		if decoded, ok := decodeValue(s.cdc, storeKey.Name(), key, value); ok {
+			change.Value = decoded
+		} else {
+			change.RawValue = append([]byte{}, value...)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending = append(s.pending, change)
+
+	return nil
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener, writing the changes of
+// the previous block.
+func (s *JSONLStreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.height == 0 {
+		// the genesis state is committed by InitChain, before the first block,
+		// its changes are written at height 0 with the time of the first block
+		s.time = req.Header.Time
+	}
+	err := s.flush()
+	s.height, s.time = req.Header.Height, req.Header.Time
+
+	return err
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (s *JSONLStreamingService) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (s *JSONLStreamingService) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// Close implements io.Closer, writing the buffered changes and closing the
+// files.
+func (s *JSONLStreamingService) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.flush()
+	for _, file := range s.files {
+		if closeErr := file.close(); err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
+
+// flush writes the buffered changes to the files of their stores, tagged with
+// the current block, then rotates the files which reached their maximum size.
+func (s *JSONLStreamingService) flush() error {
+	if len(s.pending) == 0 {
+		return nil
+	}
+	changes := s.pending
+	s.pending = nil
+
+	written := make(map[string]bool)
+	for _, change := range changes {
+		change.Height, change.Time = s.height, s.time
+		bz, err := json.Marshal(change)
+		if err != nil {
+			return err
+		}
+		if err := s.files[change.StoreKey].write(s.height, append(bz, '\n')); err != nil {
+			return fmt.Errorf("failed to write %s changes of block %d: %w", change.StoreKey, s.height, err)
+		}
+		written[change.StoreKey] = true
+	}
+
+	storeKeys := make([]string, 0, len(written))
+	for storeKey := range written {
+		storeKeys = append(storeKeys, storeKey)
+	}
+	sort.Strings(storeKeys)
+
+	for _, storeKey := range storeKeys {
+		if err := s.files[storeKey].endBlock(); err != nil {
+			return fmt.Errorf("failed to rotate %s changes: %w", storeKey, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package streaming
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestJSONLStreamingService(t *testing.T) {
+	dir := t.TempDir()
+	bankKey, otherKey := storetypes.NewKVStoreKey(banktypes.StoreKey), storetypes.NewKVStoreKey("other")
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// files are rotated after every block, keeping two files per store
+	s, err := newJSONLStreamingService(dir, []storetypes.StoreKey{bankKey, otherKey}, cdc, 1, 2)
+	require.NoError(t, err)
+
+	addr := sdk.AccAddress("addr________________")
+	amount, err := sdk.NewInt(100).Marshal()
+	require.NoError(t, err)
+	balanceKey := append(append([]byte{}, banktypes.BalancesPrefix...), address.MustLengthPrefix(addr)...)
+	balanceKey = append(balanceKey, "uatom"...)
+
+	blockTime := time.Unix(1_650_000_000, 0).UTC()
+	for height := int64(1); height <= 3; height++ {
+		header := tmproto.Header{Height: height, Time: blockTime.Add(time.Duration(height) * time.Second)}
+		require.NoError(t, s.ListenBeginBlock(sdk.Context{}, abci.RequestBeginBlock{Header: header}, abci.ResponseBeginBlock{}))
+
+		// the changes of the block are reported on commit
+		require.NoError(t, s.OnWrite(otherKey, []byte{0x01}, []byte{0xff}, false))
+		require.NoError(t, s.OnWrite(bankKey, balanceKey, amount, false))
+		require.NoError(t, s.OnWrite(bankKey, []byte{0x09}, nil, true))
+	}
+	require.NoError(t, s.Close())
+
+	var changes []KVChange
+	stores, err := Stores(dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{banktypes.StoreKey, "other"}, stores)
+	require.NoError(t, Replay(dir, stores, 0, 0, func(change KVChange) error {
+		changes = append(changes, change)
+		return nil
+	}))
+
+	// the oldest files have been deleted
+	require.Len(t, changes, 6)
+	require.Equal(t, int64(2), changes[0].Height)
+	require.Equal(t, blockTime.Add(2*time.Second), changes[0].Time)
+	require.Equal(t, banktypes.StoreKey, changes[0].StoreKey)
+	require.JSONEq(t, `{"address":"`+addr.String()+`","coin":{"denom":"uatom","amount":"100"}}`, string(changes[0].Value))
+	require.True(t, changes[1].Delete)
+	require.Equal(t, "other", changes[2].StoreKey)
+	require.Equal(t, []byte{0xff}, changes[2].RawValue)
+	require.Nil(t, changes[2].Value)
+	require.Equal(t, int64(3), changes[3].Height)
+	require.Equal(t, blockTime.Add(3*time.Second), changes[3].Time)
+
+	// a range only returns the changes of its heights
+	changes = nil
+	require.NoError(t, Replay(dir, []string{"other"}, 3, 3, func(change KVChange) error {
+		changes = append(changes, change)
+		return nil
+	}))
+	require.Len(t, changes, 1)
+	bz, err := json.Marshal(changes[0])
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"height":3`)
+}
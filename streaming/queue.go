@@ -0,0 +1,109 @@
+package streaming
+
+import (
+	"encoding/binary"
+	"sync"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// eventQueue is an on-disk queue of the block events to deliver to each sink.
+// Every sink has its own queue, so a failing sink does not hold back the
+// other ones, and an entry is removed once delivered.
+type eventQueue struct {
+	db dbm.DB
+
+	mu sync.Mutex
+	// next is the sequence of the next entry of each sink queue.
+	next map[string]uint64
+}
+
+func newEventQueue(db dbm.DB, sinks []string) (*eventQueue, error) {
+	q := &eventQueue{
+		db:   db,
+		next: make(map[string]uint64, len(sinks)),
+	}
+
+	for _, sink := range sinks {
+		iter, err := db.ReverseIterator(queuePrefix(sink), storetypes.PrefixEndBytes(queuePrefix(sink)))
+		if err != nil {
+			return nil, err
+		}
+		if iter.Valid() {
+			q.next[sink] = queueSeq(iter.Key()) + 1
+		}
+		err = iter.Error()
+		iter.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return q, nil
+}
+
+// queuePrefix returns the key prefix of the queue of a sink.
+func queuePrefix(sink string) []byte {
+	key := make([]byte, 2, 2+len(sink))
+	binary.BigEndian.PutUint16(key, uint16(len(sink)))
+
+	return append(key, sink...)
+}
+
+// queueKey returns the key of an entry of the queue of a sink.
+func queueKey(sink string, seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+
+	return append(queuePrefix(sink), key...)
+}
+
+// queueSeq returns the sequence of an entry from its key.
+func queueSeq(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// push appends data to the queues of the given sinks.
+func (q *eventQueue) push(sinks []string, data []byte) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	batch := q.db.NewBatch()
+	defer batch.Close()
+
+	for _, sink := range sinks {
+		if err := batch.Set(queueKey(sink, q.next[sink]), data); err != nil {
+			return err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	for _, sink := range sinks {
+		q.next[sink]++
+	}
+
+	return nil
+}
+
+// peek returns the oldest entry of the queue of a sink, or false if the queue
+// is empty.
+func (q *eventQueue) peek(sink string) (uint64, []byte, bool, error) {
+	iter, err := q.db.Iterator(queuePrefix(sink), storetypes.PrefixEndBytes(queuePrefix(sink)))
+	if err != nil {
+		return 0, nil, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil, false, iter.Error()
+	}
+
+	return queueSeq(iter.Key()), append([]byte{}, iter.Value()...), true, nil
+}
+
+// remove removes a delivered entry from the queue of a sink.
+func (q *eventQueue) remove(sink string, seq uint64) error {
+	return q.db.DeleteSync(queueKey(sink, seq))
+}
@@ -0,0 +1,268 @@
+package streaming
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Stores returns the names of the stores with JSONL files in dir.
+func Stores(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var stores []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			stores = append(stores, entry.Name())
+		}
+	}
+	sort.Strings(stores)
+
+	return stores, nil
+}
+
+// Replay calls fn with the changes of the given stores written to the JSONL
+// files in dir, from fromHeight to toHeight inclusive, a zero toHeight
+// meaning the last written height. The changes are ordered by height, then by
+// store, and keep the order they were written in within a store.
+func Replay(dir string, stores []string, fromHeight, toHeight int64, fn func(KVChange) error) error {
+	readers := make([]*changeReader, 0, len(stores))
+	defer func() {
+		for _, reader := range readers {
+			reader.close()
+		}
+	}()
+
+	for _, store := range stores {
+		files, err := storeFiles(filepath.Join(dir, store))
+		if err != nil {
+			return err
+		}
+		// skip the files ending before the first height
+		for len(files) > 1 {
+			next, err := fileHeight(files[1])
+			if err != nil || next > fromHeight {
+				break
+			}
+			files = files[1:]
+		}
+
+		reader := &changeReader{files: files}
+		readers = append(readers, reader)
+		if err := reader.next(); err != nil {
+			return err
+		}
+	}
+
+	for {
+		// pick the store with the lowest next height, the stores being sorted
+		var current *changeReader
+		for _, reader := range readers {
+			if reader.change != nil && (current == nil || reader.change.Height < current.change.Height) {
+				current = reader
+			}
+		}
+		if current == nil || (toHeight > 0 && current.change.Height > toHeight) {
+			return nil
+		}
+
+		height := current.change.Height
+		for current.change != nil && current.change.Height == height {
+			if height >= fromHeight {
+				if err := fn(*current.change); err != nil {
+					return err
+				}
+			}
+			if err := current.next(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// changeReader reads the changes of the JSONL files of a store.
+type changeReader struct {
+	files   []string
+	file    *os.File
+	scanner *bufio.Scanner
+	change  *KVChange
+}
+
+// next reads the next change of the store, which is nil once every file has
+// been read.
+func (r *changeReader) next() error {
+	for {
+		if r.scanner != nil && r.scanner.Scan() {
+			var change KVChange
+			if err := json.Unmarshal(r.scanner.Bytes(), &change); err != nil {
+				return fmt.Errorf("invalid change in %s: %w", r.file.Name(), err)
+			}
+			r.change = &change
+			return nil
+		}
+		if r.scanner != nil {
+			if err := r.scanner.Err(); err != nil {
+				return err
+			}
+		}
+
+		r.close()
+		r.change = nil
+		if len(r.files) == 0 {
+			return nil
+		}
+
+		file, err := os.Open(r.files[0])
+		if err != nil {
+			return err
+		}
+		r.files = r.files[1:]
+		r.file, r.scanner = file, newScanner(file)
+	}
+}
+
+func (r *changeReader) close() {
+	if r.file != nil {
+		r.file.Close()
+		r.file, r.scanner = nil, nil
+	}
+}
+
+// newScanner returns a scanner of the lines of a JSONL file, which may hold
+// large values.
+func newScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64<<20)
+
+	return scanner
+}
+
+// Follow calls fn with the changes of the given stores written to the JSONL
+// files in dir from now on, polling the files at the given interval, until the
+// context is done. Changes are passed as they are written, so changes of
+// different stores are not ordered.
+func Follow(ctx context.Context, dir string, stores []string, interval time.Duration, fn func(KVChange) error) error {
+	followers := make([]*fileFollower, len(stores))
+	for i, store := range stores {
+		followers[i] = &fileFollower{dir: filepath.Join(dir, store)}
+		if err := followers[i].seekEnd(); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, follower := range followers {
+			if err := follower.read(fn); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// fileFollower follows the JSONL files of a store.
+type fileFollower struct {
+	dir    string
+	path   string
+	offset int64
+}
+
+// seekEnd moves the follower to the end of the last file of the store.
+func (f *fileFollower) seekEnd() error {
+	files, err := storeFiles(f.dir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	info, err := os.Stat(files[len(files)-1])
+	if err != nil {
+		return err
+	}
+	f.path, f.offset = files[len(files)-1], info.Size()
+
+	return nil
+}
+
+// read passes the complete lines written since the last read to fn, moving
+// to the next files of the store once they are created.
+func (f *fileFollower) read(fn func(KVChange) error) error {
+	files, err := storeFiles(f.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	for _, path := range files {
+		if path < f.path {
+			continue
+		}
+		if path != f.path {
+			f.path, f.offset = path, 0
+		}
+		if err := f.readFile(fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *fileFollower) readFile(fn func(KVChange) error) error {
+	file, err := os.Open(f.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// the file has been deleted by the rotation
+			return nil
+		}
+		return err
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(f.offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// incomplete lines are read again once complete
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		f.offset += int64(len(line))
+
+		var change KVChange
+		if err := json.Unmarshal(line, &change); err != nil {
+			return fmt.Errorf("invalid change in %s: %w", f.path, err)
+		}
+		if err := fn(change); err != nil {
+			return err
+		}
+	}
+}
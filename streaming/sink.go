@@ -0,0 +1,141 @@
+package streaming
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// eventSink is a destination of the block events.
+type eventSink interface {
+	// send delivers the JSON encoded events of a block.
+	send(data []byte) error
+	close() error
+}
+
+// newEventSink returns the sink of the given URL: file:// appends the events
+// to a local JSONL file, unix:// writes them as JSON lines to a Unix socket,
+// and http:// or https:// POSTs them to an HTTP endpoint.
+func newEventSink(rawURL string, timeout time.Duration) (eventSink, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sink %q: %w", rawURL, err)
+	}
+
+	switch u.Scheme {
+	case "file":
+		return &fileSink{path: u.Path}, nil
+	case "unix":
+		return &socketSink{path: u.Path, timeout: timeout}, nil
+	case "http", "https":
+		return &httpSink{url: rawURL, client: &http.Client{Timeout: timeout}}, nil
+	default:
+		return nil, fmt.Errorf("invalid sink %q: unsupported scheme %q", rawURL, u.Scheme)
+	}
+}
+
+// fileSink appends the events to a local file, one block per line.
+type fileSink struct {
+	path string
+	file *os.File
+}
+
+func (s *fileSink) send(data []byte) error {
+	if s.file == nil {
+		file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return err
+		}
+		s.file = file
+	}
+
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	return s.file.Sync()
+}
+
+func (s *fileSink) close() error {
+	if s.file == nil {
+		return nil
+	}
+
+	err := s.file.Close()
+	s.file = nil
+
+	return err
+}
+
+// socketSink writes the events to a Unix socket, one block per line. The
+// connection is reopened after a failure.
+type socketSink struct {
+	path    string
+	timeout time.Duration
+	conn    net.Conn
+}
+
+func (s *socketSink) send(data []byte) error {
+	if s.conn == nil {
+		conn, err := net.DialTimeout("unix", s.path, s.timeout)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+
+	if err := s.conn.SetWriteDeadline(time.Now().Add(s.timeout)); err != nil {
+		s.close()
+		return err
+	}
+	if _, err := s.conn.Write(append(data, '\n')); err != nil {
+		s.close()
+		return err
+	}
+
+	return nil
+}
+
+func (s *socketSink) close() error {
+	if s.conn == nil {
+		return nil
+	}
+
+	err := s.conn.Close()
+	s.conn = nil
+
+	return err
+}
+
+// httpSink POSTs the events of each block to an HTTP endpoint, which must
+// reply with a 2xx status code.
+type httpSink struct {
+	url    string
+	client *http.Client
+}
+
+func (s *httpSink) send(data []byte) error {
+	res, err := s.client.Post(s.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	// drain the body so that the connection can be reused
+	_, _ = io.Copy(io.Discard, res.Body)
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", res.Status)
+	}
+
+	return nil
+}
+
+func (s *httpSink) close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
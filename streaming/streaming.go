@@ -0,0 +1,105 @@
+package streaming
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdkstreaming "github.com/cosmos/cosmos-sdk/store/streaming"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/spf13/cast"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// ServiceConstructor is used to construct a Gaia streaming service, exposing
+// the given store keys.
+type ServiceConstructor func(opts servertypes.AppOptions, keys []storetypes.StoreKey, marshaller codec.Codec, logger log.Logger) (baseapp.StreamingService, error)
+
+// LoadStreamingServices loads the SDK streaming services enabled in
+// store.streamers, then the Gaia streaming services enabled in the streaming
+// section of the application configuration, onto the BaseApp. A Gaia
+// streaming service is enabled by streaming.<name>.enable, and
+// streaming.<name>.keys lists the store keys exposed to it, "*" exposing every
+// store key.
+//
+// It returns the active streaming services and the WaitGroup used to
+// synchronize with them.
+func LoadStreamingServices(
+	bApp *baseapp.BaseApp,
+	appOpts servertypes.AppOptions,
+	appCodec codec.Codec,
+	keys map[string]*storetypes.KVStoreKey,
+	constructors map[string]ServiceConstructor,
+) ([]baseapp.StreamingService, *sync.WaitGroup, error) {
+	activeStreamers, wg, err := sdkstreaming.LoadStreamingServices(bApp, appOpts, appCodec, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, 0, len(constructors))
+	for name := range constructors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !cast.ToBool(appOpts.Get(fmt.Sprintf("streaming.%s.enable", name))) {
+			continue
+		}
+
+		logger := bApp.Logger().With("module", "streaming", "service", name)
+		streamingService, err := constructors[name](appOpts, exposedStoreKeys(appOpts, name, keys), appCodec, logger)
+		if err != nil {
+			// close the services already spun up before hitting the error
+			for _, activeStreamer := range activeStreamers {
+				activeStreamer.Close()
+			}
+			return nil, nil, fmt.Errorf("failed to create %s streaming service: %w", name, err)
+		}
+
+		bApp.SetStreamingService(streamingService)
+		if err := streamingService.Stream(wg); err != nil {
+			streamingService.Close()
+			for _, activeStreamer := range activeStreamers {
+				activeStreamer.Close()
+			}
+			return nil, nil, fmt.Errorf("failed to start %s streaming service: %w", name, err)
+		}
+		activeStreamers = append(activeStreamers, streamingService)
+	}
+
+	return activeStreamers, wg, nil
+}
+
+// exposedStoreKeys returns the store keys listed in streaming.<name>.keys,
+// sorted by name.
+func exposedStoreKeys(appOpts servertypes.AppOptions, name string, keys map[string]*storetypes.KVStoreKey) []storetypes.StoreKey {
+	exposed := make(map[string]storetypes.StoreKey)
+	for _, keyName := range cast.ToStringSlice(appOpts.Get(fmt.Sprintf("streaming.%s.keys", name))) {
+		if keyName == "*" {
+			for keyName, key := range keys {
+				exposed[keyName] = key
+			}
+			break
+		}
+		if key, ok := keys[keyName]; ok {
+			exposed[keyName] = key
+		}
+	}
+
+	keyNames := make([]string, 0, len(exposed))
+	for keyName := range exposed {
+		keyNames = append(keyNames, keyName)
+	}
+	sort.Strings(keyNames)
+
+	storeKeys := make([]storetypes.StoreKey, len(keyNames))
+	for i, keyName := range keyNames {
+		storeKeys[i] = exposed[keyName]
+	}
+
+	return storeKeys
+}
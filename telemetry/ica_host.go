@@ -0,0 +1,75 @@
+package telemetry
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// msgTypeUnknown is used as message type label when the ICA packet data
+// cannot be decoded.
+const msgTypeUnknown = "unknown"
+
+var _ porttypes.IBCModule = ICAHostMiddleware{}
+
+// ICAHostMiddleware wraps the ICA host IBC module and records metrics for
+// every message executed on behalf of an interchain account. All callbacks
+// other than OnRecvPacket are passed through to the wrapped module.
+type ICAHostMiddleware struct {
+	porttypes.IBCModule
+
+	cdc codec.BinaryCodec
+}
+
+// NewICAHostMiddleware creates a new ICAHostMiddleware wrapping the given ICA
+// host IBC module.
+func NewICAHostMiddleware(app porttypes.IBCModule, cdc codec.BinaryCodec) ICAHostMiddleware {
+	return ICAHostMiddleware{
+		IBCModule: app,
+		cdc:       cdc,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im ICAHostMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+
+	result := ICAHostResultSuccess
+	if ack == nil || !ack.Success() {
+		result = ICAHostResultError
+	}
+
+	for _, msgType := range im.packetMsgTypes(packet) {
+		IncrICAHostMsg(ctx, msgType, result)
+	}
+
+	return ack
+}
+
+// packetMsgTypes returns the type URLs of the messages contained in the ICA
+// packet data.
+func (im ICAHostMiddleware) packetMsgTypes(packet channeltypes.Packet) []string {
+	var data icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return []string{msgTypeUnknown}
+	}
+
+	msgs, err := icatypes.DeserializeCosmosTx(im.cdc, data.Data)
+	if err != nil || len(msgs) == 0 {
+		return []string{msgTypeUnknown}
+	}
+
+	msgTypes := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypes[i] = sdk.MsgTypeURL(msg)
+	}
+
+	return msgTypes
+}
@@ -0,0 +1,45 @@
+package telemetry
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	liquiditykeeper "github.com/gravity-devs/liquidity/v2/x/liquidity/keeper"
+	liquiditytypes "github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+// ObserveLiquidityBatches records the size of every liquidity pool batch that
+// is going to be executed in the current block. It must be called before the
+// liquidity module's EndBlocker, which executes and marks the batches.
+func ObserveLiquidityBatches(ctx sdk.Context, k liquiditykeeper.Keeper) {
+	params := k.GetParams(ctx)
+	if params.UnitBatchHeight == 0 || ctx.BlockHeight()%int64(params.UnitBatchHeight) != 0 {
+		return
+	}
+
+	k.IterateAllPoolBatches(ctx, func(poolBatch liquiditytypes.PoolBatch) bool {
+		if poolBatch.Executed {
+			return false
+		}
+
+		ObserveLiquidityBatchSize(ctx, LiquidityBatchTypeSwap, len(k.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, poolBatch)))
+
+		deposits := 0
+		k.IterateAllPoolBatchDepositMsgStates(ctx, poolBatch, func(msg liquiditytypes.DepositMsgState) bool {
+			if !msg.Executed && !msg.ToBeDeleted && !msg.Succeeded {
+				deposits++
+			}
+			return false
+		})
+		ObserveLiquidityBatchSize(ctx, LiquidityBatchTypeDeposit, deposits)
+
+		withdrawals := 0
+		k.IterateAllPoolBatchWithdrawMsgStates(ctx, poolBatch, func(msg liquiditytypes.WithdrawMsgState) bool {
+			if !msg.Executed && !msg.ToBeDeleted && !msg.Succeeded {
+				withdrawals++
+			}
+			return false
+		})
+		ObserveLiquidityBatchSize(ctx, LiquidityBatchTypeWithdraw, withdrawals)
+
+		return false
+	})
+}
@@ -0,0 +1,122 @@
+package telemetry
+
+import (
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	sdktelemetry "github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Gaia-specific metric keys. All metrics are emitted under the "gaia" prefix
+// and are exposed on the node's existing telemetry endpoint, alongside the
+// SDK's own metrics.
+const (
+	MetricKeyGaia = "gaia"
+
+	MetricKeyFee            = "fee"
+	MetricKeyRejected       = "rejected"
+	MetricKeyICAHost        = "ica_host"
+	MetricKeyMsgExecuted    = "msg_executed"
+	MetricKeyLiquidity      = "liquidity"
+	MetricKeyBatchSize      = "batch_size"
+	MetricKeyBatchMsgsTotal = "batch_msgs_total"
+	MetricKeyIBC            = "ibc"
+	MetricKeyClient         = "client"
+	MetricKeyClients        = "clients"
+	MetricKeyTimeToExpiry   = "time_to_expiry_seconds"
+
+	LabelChainID             = "chain_id"
+	LabelMsgType             = "msg_type"
+	LabelReason              = "reason"
+	LabelResult              = "result"
+	LabelBatch               = "batch_type"
+	LabelClient              = "client_id"
+	LabelCounterpartyChainID = "counterparty_chain_id"
+	LabelStatus              = "status"
+)
+
+// Fee rejection reasons reported by the Gaia fee checker.
+const (
+	FeeRejectionInvalidTx       = "invalid_tx"
+	FeeRejectionInsufficientFee = "insufficient_fee"
+)
+
+// ICA host message execution results.
+const (
+	ICAHostResultSuccess = "success"
+	ICAHostResultError   = "error"
+)
+
+// Liquidity batch message types.
+const (
+	LiquidityBatchTypeSwap     = "swap"
+	LiquidityBatchTypeDeposit  = "deposit"
+	LiquidityBatchTypeWithdraw = "withdraw"
+)
+
+func chainLabels(ctx sdk.Context, labels ...metrics.Label) []metrics.Label {
+	return append([]metrics.Label{sdktelemetry.NewLabel(LabelChainID, ctx.ChainID())}, labels...)
+}
+
+// IncrFeeRejection increments the counter of transactions rejected by the
+// Gaia fee checker for the given reason.
+func IncrFeeRejection(ctx sdk.Context, reason string) {
+	sdktelemetry.IncrCounterWithLabels(
+		[]string{MetricKeyGaia, MetricKeyFee, MetricKeyRejected},
+		1,
+		chainLabels(ctx, sdktelemetry.NewLabel(LabelReason, reason)),
+	)
+}
+
+// IncrICAHostMsg increments the counter of messages executed by the ICA host
+// on behalf of an interchain account, labeled by message type and result.
+func IncrICAHostMsg(ctx sdk.Context, msgType, result string) {
+	sdktelemetry.IncrCounterWithLabels(
+		[]string{MetricKeyGaia, MetricKeyICAHost, MetricKeyMsgExecuted},
+		1,
+		chainLabels(ctx,
+			sdktelemetry.NewLabel(LabelMsgType, msgType),
+			sdktelemetry.NewLabel(LabelResult, result),
+		),
+	)
+}
+
+// ObserveLiquidityBatchSize records the number of messages of the given type
+// that are about to be executed in a liquidity pool batch during EndBlock.
+func ObserveLiquidityBatchSize(ctx sdk.Context, batchType string, size int) {
+	metrics.AddSampleWithLabels(
+		[]string{MetricKeyGaia, MetricKeyLiquidity, MetricKeyBatchSize},
+		float32(size),
+		chainLabels(ctx, sdktelemetry.NewLabel(LabelBatch, batchType)),
+	)
+	sdktelemetry.IncrCounterWithLabels(
+		[]string{MetricKeyGaia, MetricKeyLiquidity, MetricKeyBatchMsgsTotal},
+		float32(size),
+		chainLabels(ctx, sdktelemetry.NewLabel(LabelBatch, batchType)),
+	)
+}
+
+// SetIBCClientTimeToExpiry records the number of seconds left before an IBC
+// light client expires, negative once it expired.
+func SetIBCClientTimeToExpiry(ctx sdk.Context, clientID, counterpartyChainID string, timeToExpiry time.Duration) {
+	sdktelemetry.SetGaugeWithLabels(
+		[]string{MetricKeyGaia, MetricKeyIBC, MetricKeyClient, MetricKeyTimeToExpiry},
+		float32(timeToExpiry.Seconds()),
+		chainLabels(ctx,
+			sdktelemetry.NewLabel(LabelClient, clientID),
+			sdktelemetry.NewLabel(LabelCounterpartyChainID, counterpartyChainID),
+		),
+	)
+}
+
+// SetIBCClients records the number of IBC light clients with the given
+// status.
+func SetIBCClients(ctx sdk.Context, status string, count int) {
+	sdktelemetry.SetGaugeWithLabels(
+		[]string{MetricKeyGaia, MetricKeyIBC, MetricKeyClients},
+		float32(count),
+		chainLabels(ctx, sdktelemetry.NewLabel(LabelStatus, status)),
+	)
+}
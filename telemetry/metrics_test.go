@@ -0,0 +1,153 @@
+package telemetry_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	liquiditytypes "github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiaapp "github.com/cosmos/gaia/v8/app"
+	gaiahelpers "github.com/cosmos/gaia/v8/app/helpers"
+	gaiatelemetry "github.com/cosmos/gaia/v8/telemetry"
+)
+
+// setupSink replaces the global metrics with metrics recorded in memory,
+// without the hostname and service prefixes.
+func setupSink(t *testing.T) *metrics.InmemSink {
+	t.Helper()
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	return sink
+}
+
+func currentInterval(sink *metrics.InmemSink) *metrics.IntervalMetrics {
+	data := sink.Data()
+	return data[len(data)-1]
+}
+
+func TestMetrics(t *testing.T) {
+	sink := setupSink(t)
+	ctx := sdk.NewContext(nil, tmproto.Header{ChainID: "test-chain"}, false, log.NewNopLogger())
+
+	gaiatelemetry.IncrFeeRejection(ctx, gaiatelemetry.FeeRejectionInsufficientFee)
+	gaiatelemetry.IncrFeeRejection(ctx, gaiatelemetry.FeeRejectionInsufficientFee)
+	gaiatelemetry.IncrFeeRejection(ctx, gaiatelemetry.FeeRejectionInvalidTx)
+	gaiatelemetry.IncrICAHostMsg(ctx, "/cosmos.bank.v1beta1.MsgSend", gaiatelemetry.ICAHostResultSuccess)
+	gaiatelemetry.ObserveLiquidityBatchSize(ctx, gaiatelemetry.LiquidityBatchTypeSwap, 3)
+	gaiatelemetry.SetIBCClientTimeToExpiry(ctx, "07-tendermint-0", "counterparty", time.Hour)
+	gaiatelemetry.SetIBCClients(ctx, "Active", 2)
+
+	interval := currentInterval(sink)
+	counters := map[string]float64{}
+	for name, counter := range interval.Counters {
+		counters[name] = counter.Sum
+	}
+	require.Equal(t, map[string]float64{
+		"gaia.fee.rejected;chain_id=test-chain;reason=insufficient_fee":                                       2,
+		"gaia.fee.rejected;chain_id=test-chain;reason=invalid_tx":                                             1,
+		"gaia.ica_host.msg_executed;chain_id=test-chain;msg_type=/cosmos.bank.v1beta1.MsgSend;result=success": 1,
+		"gaia.liquidity.batch_msgs_total;chain_id=test-chain;batch_type=swap":                                 3,
+	}, counters)
+
+	require.Equal(t, float64(3), interval.Samples["gaia.liquidity.batch_size;chain_id=test-chain;batch_type=swap"].Sum)
+
+	gauges := map[string]float32{}
+	for name, gauge := range interval.Gauges {
+		gauges[name] = gauge.Value
+	}
+	require.Equal(t, map[string]float32{
+		"gaia.ibc.client.time_to_expiry_seconds;chain_id=test-chain;client_id=07-tendermint-0;counterparty_chain_id=counterparty": 3600,
+		"gaia.ibc.clients;chain_id=test-chain;status=Active":                                                                      2,
+	}, gauges)
+}
+
+// ackModule stands for the ICA host module, acknowledging every packet with
+// the same acknowledgement.
+type ackModule struct {
+	porttypes.IBCModule
+
+	ack ibcexported.Acknowledgement
+}
+
+func (m ackModule) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) ibcexported.Acknowledgement {
+	return m.ack
+}
+
+func TestICAHostMiddleware(t *testing.T) {
+	sink := setupSink(t)
+	ctx := sdk.NewContext(nil, tmproto.Header{ChainID: "test-chain"}, false, log.NewNopLogger())
+	cdc := gaiaapp.MakeEncodingConfig().Codec
+
+	addr := sdk.AccAddress("address_____________")
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+	txData, err := icatypes.SerializeCosmosTx(cdc, []sdk.Msg{send, send})
+	require.NoError(t, err)
+	data := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: txData}
+	packet := channeltypes.Packet{Data: data.GetBytes()}
+
+	// every message of a packet is counted, and the packets which cannot be
+	// decoded are counted once, as unknown
+	gaiatelemetry.NewICAHostMiddleware(ackModule{ack: channeltypes.NewResultAcknowledgement([]byte{1})}, cdc).OnRecvPacket(ctx, packet, nil)
+	middleware := gaiatelemetry.NewICAHostMiddleware(ackModule{ack: channeltypes.NewErrorAcknowledgement("failed")}, cdc)
+	middleware.OnRecvPacket(ctx, packet, nil)
+	middleware.OnRecvPacket(ctx, channeltypes.Packet{Data: []byte("invalid")}, nil)
+
+	counters := map[string]float64{}
+	for name, counter := range currentInterval(sink).Counters {
+		counters[name] = counter.Sum
+	}
+	require.Equal(t, map[string]float64{
+		"gaia.ica_host.msg_executed;chain_id=test-chain;msg_type=/cosmos.bank.v1beta1.MsgSend;result=success": 2,
+		"gaia.ica_host.msg_executed;chain_id=test-chain;msg_type=/cosmos.bank.v1beta1.MsgSend;result=error":   2,
+		"gaia.ica_host.msg_executed;chain_id=test-chain;msg_type=unknown;result=error":                        1,
+	}, counters)
+}
+
+func TestObserveLiquidityBatches(t *testing.T) {
+	app := gaiahelpers.Setup(t, false, 0)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "test-chain", Height: 1})
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	creator := sdk.AccAddress("creator_____________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000), sdk.NewInt64Coin("uother", 100_000_000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, creator, coins))
+	pool, err := app.LiquidityKeeper.CreatePool(ctx, liquiditytypes.NewMsgCreatePool(creator, liquiditytypes.DefaultPoolTypeID,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10_000_000), sdk.NewInt64Coin("uother", 10_000_000))))
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = app.LiquidityKeeper.DepositWithinBatch(ctx, liquiditytypes.NewMsgDepositWithinBatch(creator, pool.Id,
+			sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000), sdk.NewInt64Coin("uother", 1_000_000))))
+		require.NoError(t, err)
+	}
+
+	sink := setupSink(t)
+	gaiatelemetry.ObserveLiquidityBatches(ctx, app.LiquidityKeeper)
+
+	samples := map[string]float64{}
+	for name, sample := range currentInterval(sink).Samples {
+		samples[name] = sample.Sum
+	}
+	require.Equal(t, map[string]float64{
+		"gaia.liquidity.batch_size;chain_id=test-chain;batch_type=swap":     0,
+		"gaia.liquidity.batch_size;chain_id=test-chain;batch_type=deposit":  2,
+		"gaia.liquidity.batch_size;chain_id=test-chain;batch_type=withdraw": 0,
+	}, samples)
+}
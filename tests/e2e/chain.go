@@ -27,7 +27,7 @@ var (
 )
 
 func init() {
-	encodingConfig = gaia.MakeTestEncodingConfig()
+	encodingConfig = gaia.MakeEncodingConfig()
 
 	encodingConfig.InterfaceRegistry.RegisterImplementations(
 		(*sdk.Msg)(nil),
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/apr/types"
+)
+
+// FlagAmount is the flag of the delegation amount whose rewards are estimated.
+const FlagAmount = "amount"
+
+// GetCmdQueryApr implements the staking APR query command.
+func GetCmdQueryApr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apr [validator]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the staking APR, optionally net of the commission of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the nominal and real staking APR, derived from the annual provisions
+of the mint module net of the community tax and shared by the bonded tokens.
+The nominal APR assumes the blocks per year of the mint parameters, while the
+real APR uses the block rate observed over the recent blocks. Fees are not
+included.
+
+If a validator is given, the APR is net of its commission. If an amount of
+bond denom tokens is given, its annual, monthly and daily rewards are
+estimated at the real APR.
+
+Example:
+$ %s query apr cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0 --amount 1000000
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryAprRequest{DelegationAmount: amount}
+			if len(args) > 0 {
+				req.ValidatorAddress = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Apr(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagAmount, "", "Amount of bond denom tokens whose rewards are estimated")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// year is the duration of a year assumed by the default blocks per year of
+// the mint module.
+const year = 8766 * time.Hour
+
+// nominalAPR returns the APR of the bonded tokens if the annual provisions,
+// net of the community tax, are minted over a year.
+func nominalAPR(annualProvisions, communityTax sdk.Dec, bondedTokens sdk.Int) sdk.Dec {
+	if !bondedTokens.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return annualProvisions.Mul(sdk.OneDec().Sub(communityTax)).QuoInt(bondedTokens)
+}
+
+// realAPR returns the nominal APR at the actual block rate, the annual
+// provisions being minted over blocksPerYear blocks.
+func realAPR(nominal sdk.Dec, blocksPerYear, actualBlocksPerYear uint64) sdk.Dec {
+	if blocksPerYear == 0 || actualBlocksPerYear == 0 {
+		return nominal
+	}
+
+	return nominal.MulInt64(int64(actualBlocksPerYear)).QuoInt64(int64(blocksPerYear))
+}
+
+// blocksPerYear returns the number of blocks per year at the rate of the given
+// number of blocks produced over the given duration.
+func blocksPerYear(blocks int64, elapsed time.Duration) uint64 {
+	if blocks <= 0 || elapsed <= 0 {
+		return 0
+	}
+
+	return uint64(sdk.NewDec(blocks).MulInt64(int64(year)).QuoInt64(int64(elapsed)).TruncateInt64())
+}
+
+// netOfCommission returns an APR net of a commission rate.
+func netOfCommission(apr, commissionRate sdk.Dec) sdk.Dec {
+	return apr.Mul(sdk.OneDec().Sub(commissionRate))
+}
+
+// estimateRewards returns the annual, monthly and daily rewards of a
+// delegation at the given APR.
+func estimateRewards(delegation sdk.Coin, apr sdk.Dec) (annual, monthly, daily sdk.Coin) {
+	rewards := apr.MulInt(delegation.Amount)
+
+	annual = sdk.NewCoin(delegation.Denom, rewards.TruncateInt())
+	monthly = sdk.NewCoin(delegation.Denom, rewards.QuoInt64(12).TruncateInt())
+	daily = sdk.NewCoin(delegation.Denom, rewards.MulInt64(int64(24*time.Hour)).QuoInt64(int64(year)).TruncateInt())
+
+	return annual, monthly, daily
+}
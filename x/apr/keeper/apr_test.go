@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPR(t *testing.T) {
+	// 100 provisions, 2% of which go to the community pool, shared by 490
+	// bonded tokens
+	nominal := nominalAPR(sdk.NewDec(100), sdk.NewDecWithPrec(2, 2), sdk.NewInt(490))
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), nominal)
+	require.True(t, nominalAPR(sdk.NewDec(100), sdk.ZeroDec(), sdk.ZeroInt()).IsZero())
+
+	// blocks are produced every 6s instead of the expected 5s
+	actual := blocksPerYear(100, 600*time.Second)
+	require.Equal(t, uint64(year/(6*time.Second)), actual)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), realAPR(sdk.NewDecWithPrec(3, 1), 6000, 5000))
+	require.Equal(t, nominal, realAPR(nominal, 6311520, 0))
+	require.Zero(t, blocksPerYear(0, time.Hour))
+
+	require.Equal(t, sdk.NewDecWithPrec(18, 2), netOfCommission(nominal, sdk.NewDecWithPrec(1, 1)))
+
+	annual, monthly, daily := estimateRewards(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewDecWithPrec(18, 2))
+	require.Equal(t, sdk.NewInt64Coin("uatom", 180_000), annual)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 15_000), monthly)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 492), daily)
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/apr/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the APR gRPC query service. The staking yield is derived
+// from the annual provisions of the mint module, net of the community tax of
+// the distribution module, shared by the bonded tokens. The nominal APR
+// assumes the blocks per year of the mint parameters, while the real APR
+// scales it by the block rate observed over the historical info kept by the
+// staking module.
+type Querier struct {
+	mintKeeper    types.MintKeeper
+	distrKeeper   types.DistrKeeper
+	stakingKeeper types.StakingKeeper
+}
+
+// NewQuerier returns a new APR Querier.
+func NewQuerier(mintKeeper types.MintKeeper, distrKeeper types.DistrKeeper, stakingKeeper types.StakingKeeper) Querier {
+	return Querier{
+		mintKeeper:    mintKeeper,
+		distrKeeper:   distrKeeper,
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+// Apr implements the Query/Apr gRPC method.
+func (q Querier) Apr(c context.Context, req *types.QueryAprRequest) (*types.QueryAprResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	minter, params := q.mintKeeper.GetMinter(ctx), q.mintKeeper.GetParams(ctx)
+	communityTax := q.distrKeeper.GetCommunityTax(ctx)
+	actualBlocksPerYear := q.actualBlocksPerYear(ctx)
+
+	nominal := nominalAPR(minter.AnnualProvisions, communityTax, q.stakingKeeper.TotalBondedTokens(ctx))
+	res := &types.QueryAprResponse{
+		BondDenom:           q.stakingKeeper.BondDenom(ctx),
+		Inflation:           minter.Inflation,
+		AnnualProvisions:    minter.AnnualProvisions,
+		BondedRatio:         q.stakingKeeper.BondedRatio(ctx),
+		CommunityTax:        communityTax,
+		BlocksPerYear:       params.BlocksPerYear,
+		ActualBlocksPerYear: actualBlocksPerYear,
+		NominalApr:          nominal,
+		RealApr:             realAPR(nominal, params.BlocksPerYear, actualBlocksPerYear),
+	}
+
+	apr := res.RealApr
+	if req.ValidatorAddress != "" {
+		valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+		}
+		validator, found := q.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddress)
+		}
+
+		res.Validator = &types.ValidatorApr{
+			OperatorAddress: validator.OperatorAddress,
+			Bonded:          validator.IsBonded(),
+			CommissionRate:  validator.Commission.Rate,
+			NominalApr:      sdk.ZeroDec(),
+			RealApr:         sdk.ZeroDec(),
+		}
+		if validator.IsBonded() {
+			res.Validator.NominalApr = netOfCommission(res.NominalApr, validator.Commission.Rate)
+			res.Validator.RealApr = netOfCommission(res.RealApr, validator.Commission.Rate)
+		}
+		apr = res.Validator.RealApr
+	}
+
+	if req.DelegationAmount != "" {
+		amount, ok := sdk.NewIntFromString(req.DelegationAmount)
+		if !ok || amount.IsNegative() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid delegation amount %q", req.DelegationAmount)
+		}
+
+		delegation := sdk.NewCoin(res.BondDenom, amount)
+		annual, monthly, daily := estimateRewards(delegation, apr)
+		res.EstimatedRewards = &types.RewardEstimate{
+			Delegation: delegation,
+			Annual:     annual,
+			Monthly:    monthly,
+			Daily:      daily,
+		}
+	}
+
+	return res, nil
+}
+
+// actualBlocksPerYear returns the number of blocks per year at the block rate
+// observed between the oldest and the latest historical info kept by the
+// staking module, or 0 if fewer than two are kept.
+func (q Querier) actualBlocksPerYear(ctx sdk.Context) uint64 {
+	entries := int64(q.stakingKeeper.HistoricalEntries(ctx))
+	if entries < 2 {
+		return 0
+	}
+
+	latest, found := q.stakingKeeper.GetHistoricalInfo(ctx, ctx.BlockHeight())
+	if !found {
+		return 0
+	}
+	oldestHeight := ctx.BlockHeight() - entries + 1
+	if oldestHeight < 1 {
+		oldestHeight = 1
+	}
+	oldest, found := q.stakingKeeper.GetHistoricalInfo(ctx, oldestHeight)
+	if !found {
+		return 0
+	}
+
+	return blocksPerYear(latest.Header.Height-oldest.Header.Height, latest.Header.Time.Sub(oldest.Header.Time))
+}
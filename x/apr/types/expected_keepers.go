@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MintKeeper defines the expected mint keeper.
+type MintKeeper interface {
+	GetParams(ctx sdk.Context) minttypes.Params
+	GetMinter(ctx sdk.Context) minttypes.Minter
+}
+
+// DistrKeeper defines the expected distribution keeper.
+type DistrKeeper interface {
+	GetCommunityTax(ctx sdk.Context) sdk.Dec
+}
+
+// StakingKeeper defines the expected staking keeper.
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	BondedRatio(ctx sdk.Context) sdk.Dec
+	TotalBondedTokens(ctx sdk.Context) sdk.Int
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool)
+	HistoricalEntries(ctx sdk.Context) uint32
+	GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
+}
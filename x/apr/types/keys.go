@@ -0,0 +1,4 @@
+package types
+
+// ModuleName defines the name of the APR query service.
+const ModuleName = "apr"
@@ -0,0 +1,19 @@
+package autocompound
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/autocompound/keeper"
+	"github.com/cosmos/gaia/v8/x/autocompound/types"
+)
+
+// EndBlocker compounds the rewards of the delegators who opted in, every
+// interval blocks.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.CompoundAll(ctx)
+}
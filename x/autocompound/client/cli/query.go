@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/autocompound/types"
+)
+
+// GetQueryCmd returns the cli query commands for the autocompound module.
+func GetQueryCmd() *cobra.Command {
+	autocompoundQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the autocompound module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	autocompoundQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryConfig(),
+		GetCmdQueryConfigs(),
+	)
+
+	return autocompoundQueryCmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the auto-compounding parameters and the address to grant the authorizations to",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConfig implements the config query command.
+func GetCmdQueryConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the auto-compounding configuration of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the auto-compounding configuration of a delegator who opted in.
+
+Example:
+$ %s query %s config cosmos1qnk2n4nlkpw9xfqntladh74w6ujtulwn7j8za9
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Config(cmd.Context(), &types.QueryConfigRequest{DelegatorAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConfigs implements the configs query command.
+func GetCmdQueryConfigs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "configs",
+		Args:  cobra.NoArgs,
+		Short: "Query the auto-compounding configurations of all the delegators who opted in",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Configs(cmd.Context(), &types.QueryConfigsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "configs")
+
+	return cmd
+}
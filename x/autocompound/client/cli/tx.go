@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/autocompound/types"
+)
+
+// FlagMinReward is the flag of the minimum amount of rewards to compound.
+const FlagMinReward = "min-reward"
+
+// GetTxCmd returns the transaction commands for the autocompound module.
+func GetTxCmd() *cobra.Command {
+	autocompoundTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Auto-compounding transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	autocompoundTxCmd.AddCommand(
+		NewCmdEnable(),
+		NewCmdDisable(),
+	)
+
+	return autocompoundTxCmd
+}
+
+// NewCmdEnable implements the command opting in to auto-compounding.
+func NewCmdEnable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable",
+		Args:  cobra.NoArgs,
+		Short: "Opt in to the auto-compounding of the staking rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt in to the auto-compounding of the staking rewards, or update the
+minimum amount of bond denom rewards to compound.
+
+Every interval blocks, the rewards are withdrawn and delegated back to the
+validators they were earned from, less a fee, once they reach the minimum
+amount. The messages are executed through authz on behalf of the delegator,
+who must grant the grantee address returned by the params query the
+authorizations to withdraw its rewards and to delegate. Revoking them stops
+the compounding.
+
+Example:
+$ %[1]s query %[2]s params
+$ %[1]s tx authz grant <grantee> generic --msg-type /cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward --from mykey
+$ %[1]s tx authz grant <grantee> delegate --allowed-validators cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0 --from mykey
+$ %[1]s tx %[2]s enable --min-reward 1000000 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			minRewardStr, err := cmd.Flags().GetString(FlagMinReward)
+			if err != nil {
+				return err
+			}
+			minReward, ok := sdk.NewIntFromString(minRewardStr)
+			if !ok {
+				return fmt.Errorf("invalid min reward %q", minRewardStr)
+			}
+
+			msg := types.NewMsgEnableAutoCompound(clientCtx.GetFromAddress(), minReward)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMinReward, "0", "Minimum amount of bond denom rewards to compound, on top of the min reward parameter")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdDisable implements the command opting out of auto-compounding.
+func NewCmdDisable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable",
+		Args:  cobra.NoArgs,
+		Short: "Opt out of the auto-compounding of the staking rewards",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDisableAutoCompound(clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
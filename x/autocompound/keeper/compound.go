@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v8/x/autocompound/types"
+)
+
+// errOutOfGas is returned when compounding the rewards of a delegator runs
+// out of the gas left in the budget of the run.
+var errOutOfGas = errors.New("out of gas")
+
+// CompoundAll compounds the rewards of the delegators who opted in, if the
+// block height is a multiple of the compounding interval.
+//
+// The delegators are compounded in address order, each in its own cached
+// context, until the gas budget of the run is spent. The next run then
+// resumes at the delegator which did not fit in the budget, so that all the
+// delegators are compounded in turn however many they are.
+func (k Keeper) CompoundAll(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.Interval == 0 || ctx.BlockHeight()%int64(params.Interval) != 0 {
+		return
+	}
+
+	var (
+		cursor    = k.getCursor(ctx)
+		budget    = params.MaxGas
+		processed int
+		next      []byte
+		updated   []types.Config
+	)
+	compound := func(config types.Config) bool {
+		key := address.MustLengthPrefix(config.GetDelegatorAddr())
+
+		compounded, gasUsed, err := k.compoundInBudget(ctx, config, params, budget)
+		if errors.Is(err, errOutOfGas) && processed > 0 {
+			// resume at this delegator in the next run
+			next = key
+			return true
+		}
+		budget -= gasUsed
+		processed++
+
+		switch {
+		case err != nil:
+			k.Logger(ctx).Debug("failed to compound rewards", "delegator", config.DelegatorAddress, "err", err)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeCompoundFailed,
+				sdk.NewAttribute(types.AttributeKeyDelegator, config.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			))
+		case compounded:
+			config.LastHeight = ctx.BlockHeight()
+			updated = append(updated, config)
+		}
+
+		return false
+	}
+
+	// compound the delegators from the cursor to the last one, then wrap
+	// around to the ones before the cursor
+	k.IterateConfigs(ctx, cursor, compound)
+	if next == nil && cursor != nil {
+		k.IterateConfigs(ctx, nil, func(config types.Config) bool {
+			key := address.MustLengthPrefix(config.GetDelegatorAddr())
+			if bytes.Compare(key, cursor) >= 0 {
+				return true
+			}
+			return compound(config)
+		})
+	}
+
+	// the configs are not written while being iterated
+	for _, config := range updated {
+		k.SetConfig(ctx, config)
+	}
+	k.setCursor(ctx, next)
+}
+
+// compoundInBudget compounds the rewards of a delegator in a cached context
+// with the given gas limit, writing its state changes and events only if the
+// rewards are compounded. It returns whether they are, and the gas used.
+func (k Keeper) compoundInBudget(ctx sdk.Context, config types.Config, params types.Params, budget uint64) (compounded bool, gasUsed uint64, err error) {
+	cacheCtx, write := ctx.CacheContext()
+	gasMeter := sdk.NewGasMeter(budget)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			compounded, gasUsed, err = false, budget, errOutOfGas
+		}
+	}()
+
+	compounded, err = k.compound(cacheCtx, config, params)
+	if err != nil || !compounded {
+		return false, gasMeter.GasConsumed(), err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return true, gasMeter.GasConsumed(), nil
+}
+
+// compound withdraws the rewards of a delegator and delegates them back to
+// the validators they were earned from, less the compounding fee. The
+// messages are dispatched through authz, so that the delegator must have
+// granted the module the authorizations to withdraw its rewards and delegate.
+// It returns false if the bond denom rewards are below the threshold of the
+// delegator.
+func (k Keeper) compound(ctx sdk.Context, config types.Config, params types.Params) (bool, error) {
+	delegator := config.GetDelegatorAddr()
+	if !k.distrKeeper.GetDelegatorWithdrawAddr(ctx, delegator).Equals(delegator) {
+		return false, types.ErrWithdrawAddress
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	delegations := k.stakingKeeper.GetAllDelegatorDelegations(ctx, delegator)
+
+	var withdrawals []sdk.Msg
+	for _, delegation := range delegations {
+		withdrawals = append(withdrawals, distrtypes.NewMsgWithdrawDelegatorReward(delegator, delegation.GetValidatorAddr()))
+	}
+	results, err := k.authzKeeper.DispatchActions(ctx, k.Grantee(), withdrawals)
+	if err != nil {
+		return false, err
+	}
+
+	total := sdk.ZeroInt()
+	rewards := make([]sdk.Int, len(results))
+	for i, result := range results {
+		var res distrtypes.MsgWithdrawDelegatorRewardResponse
+		if err := res.Unmarshal(result); err != nil {
+			return false, err
+		}
+		rewards[i] = res.Amount.AmountOf(bondDenom)
+		total = total.Add(rewards[i])
+	}
+
+	threshold := sdk.MaxInt(params.MinReward, config.MinReward)
+	if total.IsZero() || total.LT(threshold) || total.LTE(params.Fee) {
+		return false, nil
+	}
+
+	if params.Fee.IsPositive() {
+		fee := sdk.NewCoins(sdk.NewCoin(bondDenom, params.Fee))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegator, authtypes.FeeCollectorName, fee); err != nil {
+			return false, err
+		}
+	}
+
+	// the fee is shared by the validators pro rata of their rewards
+	net := total.Sub(params.Fee)
+	compounded := sdk.ZeroInt()
+	var delegates []sdk.Msg
+	for i, delegation := range delegations {
+		amount := rewards[i].Mul(net).Quo(total)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		delegates = append(delegates, stakingtypes.NewMsgDelegate(delegator, delegation.GetValidatorAddr(), sdk.NewCoin(bondDenom, amount)))
+		compounded = compounded.Add(amount)
+	}
+	if _, err := k.authzKeeper.DispatchActions(ctx, k.Grantee(), delegates); err != nil {
+		return false, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCompound,
+		sdk.NewAttribute(types.AttributeKeyDelegator, config.DelegatorAddress),
+		sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(bondDenom, compounded).String()),
+		sdk.NewAttribute(types.AttributeKeyFee, sdk.NewCoin(bondDenom, params.Fee).String()),
+	))
+
+	return true, nil
+}
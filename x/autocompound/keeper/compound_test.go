@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	gaiahelpers "github.com/cosmos/gaia/v8/app/helpers"
+	"github.com/cosmos/gaia/v8/x/autocompound/keeper"
+	"github.com/cosmos/gaia/v8/x/autocompound/types"
+)
+
+// delegate delegates to the validator, and grants the module the
+// authorizations to compound the rewards of the delegator.
+func delegate(t *testing.T, f gaiahelpers.StakingFixture, delegator sdk.AccAddress, amount sdk.Int, grant bool) {
+	f.Delegate(t, delegator, amount)
+	if !grant {
+		return
+	}
+
+	grantee := f.App.AutoCompoundKeeper.Grantee()
+	require.NoError(t, f.App.AuthzKeeper.SaveGrant(f.Ctx, grantee, delegator, authz.NewGenericAuthorization(sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{})), nil))
+	stakeAuthorization, err := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{f.Validator}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil)
+	require.NoError(t, err)
+	require.NoError(t, f.App.AuthzKeeper.SaveGrant(f.Ctx, grantee, delegator, stakeAuthorization, nil))
+}
+
+func TestCompoundAll(t *testing.T) {
+	f := gaiahelpers.SetupStaking(t, sdk.NewInt(1_000_000))
+	k, ctx := f.App.AutoCompoundKeeper, f.Ctx
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.NewParams(10, 10_000_000, sdk.NewInt(1_000), sdk.NewInt(100))
+	k.SetParams(ctx, params)
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	carol := sdk.AccAddress("carol_______________")
+	delegate(t, f, alice, sdk.NewInt(1_000_000), true)
+	delegate(t, f, bob, sdk.NewInt(1_000_000), true)
+	// carol opts in without granting the authorizations
+	delegate(t, f, carol, sdk.NewInt(1_000_000), false)
+	for _, delegator := range []sdk.AccAddress{alice, bob, carol} {
+		_, err := msgServer.EnableAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgEnableAutoCompound(delegator, sdk.ZeroInt()))
+		require.NoError(t, err)
+	}
+	// bob requires more rewards than the others
+	_, err := msgServer.EnableAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgEnableAutoCompound(bob, sdk.NewInt(100_000)))
+	require.NoError(t, err)
+
+	// the 4 delegations of 1 000 000 tokens share 40 000 tokens of rewards
+	f.AllocateRewards(t, sdk.NewInt(40_000))
+
+	// nothing happens between intervals
+	k.CompoundAll(ctx.WithBlockHeight(9))
+	require.Equal(t, sdk.NewInt(1_000_000), f.Delegation(alice))
+
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	feeCollector := f.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := f.App.BankKeeper.GetBalance(ctx, feeCollector, f.BondDenom)
+	bobPeriod := f.App.DistrKeeper.GetDelegatorStartingInfo(ctx, f.Validator, bob).PreviousPeriod
+	k.CompoundAll(ctx)
+
+	// alice's rewards are delegated less the fee
+	require.Equal(t, sdk.NewInt(1_009_900), f.Delegation(alice))
+	require.Equal(t, sdk.NewInt(100), f.App.BankKeeper.GetBalance(ctx, feeCollector, f.BondDenom).Amount.Sub(feesBefore.Amount))
+	config, found := k.GetConfig(ctx, alice)
+	require.True(t, found)
+	require.Equal(t, int64(10), config.LastHeight)
+
+	// bob's rewards are below his threshold, and stay pending
+	require.Equal(t, sdk.NewInt(1_000_000), f.Delegation(bob))
+	config, _ = k.GetConfig(ctx, bob)
+	require.Zero(t, config.LastHeight)
+	// the withdrawal is reverted along with the compounding
+	require.Equal(t, bobPeriod, f.App.DistrKeeper.GetDelegatorStartingInfo(ctx, f.Validator, bob).PreviousPeriod)
+
+	// carol's compounding fails without the grants
+	require.Equal(t, sdk.NewInt(1_000_000), f.Delegation(carol))
+	var failed []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCompoundFailed {
+			failed = append(failed, string(event.Attributes[0].Value))
+		}
+	}
+	require.Equal(t, []string{carol.String()}, failed)
+}
+
+func TestCompoundAllGasBudget(t *testing.T) {
+	f := gaiahelpers.SetupStaking(t, sdk.NewInt(1_000_000))
+	k, ctx := f.App.AutoCompoundKeeper, f.Ctx
+
+	delegators := []sdk.AccAddress{
+		sdk.AccAddress("delegator1__________"),
+		sdk.AccAddress("delegator2__________"),
+		sdk.AccAddress("delegator3__________"),
+	}
+	for _, delegator := range delegators {
+		delegate(t, f, delegator, sdk.NewInt(1_000_000), true)
+		k.SetConfig(ctx, types.NewConfig(delegator, sdk.ZeroInt()))
+	}
+
+	// compounding a delegator uses about 160 000 gas, so that a budget of
+	// 250 000 fits a single delegator per run
+	k.SetParams(ctx, types.NewParams(1, 250_000, sdk.ZeroInt(), sdk.ZeroInt()))
+	var heights []int64
+	for height := int64(2); height <= 4; height++ {
+		f.AllocateRewards(t, sdk.NewInt(30_000))
+		k.CompoundAll(ctx.WithBlockHeight(height))
+
+		heights = heights[:0]
+		compounded := 0
+		for _, config := range k.GetAllConfigs(ctx) {
+			heights = append(heights, config.LastHeight)
+			if config.LastHeight == height {
+				compounded++
+			}
+		}
+		require.Equal(t, 1, compounded, "height %d", height)
+	}
+	for _, h := range heights {
+		require.NotZero(t, h, "every delegator is compounded in turn")
+	}
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/autocompound/types"
+)
+
+// GetConfig returns the configuration of a delegator, if it opted in.
+func (k Keeper) GetConfig(ctx sdk.Context, delegator sdk.AccAddress) (types.Config, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ConfigKey(delegator))
+	if bz == nil {
+		return types.Config{}, false
+	}
+
+	var config types.Config
+	k.cdc.MustUnmarshal(bz, &config)
+	return config, true
+}
+
+// SetConfig sets the configuration of a delegator.
+func (k Keeper) SetConfig(ctx sdk.Context, config types.Config) {
+	delegator := config.GetDelegatorAddr()
+	ctx.KVStore(k.storeKey).Set(types.ConfigKey(delegator), k.cdc.MustMarshal(&config))
+}
+
+// DeleteConfig deletes the configuration of a delegator.
+func (k Keeper) DeleteConfig(ctx sdk.Context, delegator sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.ConfigKey(delegator))
+}
+
+// IterateConfigs iterates over the configurations of the delegators, in key
+// order, starting at the given key, until cb returns true.
+func (k Keeper) IterateConfigs(ctx sdk.Context, start []byte, cb func(config types.Config) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(append(types.ConfigKeyPrefix, start...), sdk.PrefixEndBytes(types.ConfigKeyPrefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var config types.Config
+		k.cdc.MustUnmarshal(iterator.Value(), &config)
+		if cb(config) {
+			break
+		}
+	}
+}
+
+// GetAllConfigs returns the configurations of all the delegators.
+func (k Keeper) GetAllConfigs(ctx sdk.Context) []types.Config {
+	var configs []types.Config
+	k.IterateConfigs(ctx, nil, func(config types.Config) bool {
+		configs = append(configs, config)
+		return false
+	})
+
+	return configs
+}
+
+// getCursor returns the config key suffix of the delegator the next
+// compounding run starts at, nil to start at the first delegator.
+func (k Keeper) getCursor(ctx sdk.Context) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.CursorKey)
+}
+
+// setCursor sets the config key suffix of the delegator the next compounding
+// run starts at, deleting it if it is nil.
+func (k Keeper) setCursor(ctx sdk.Context, cursor []byte) {
+	if cursor == nil {
+		ctx.KVStore(k.storeKey).Delete(types.CursorKey)
+		return
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.CursorKey, cursor)
+}
+
+// configsStore returns the store of the configurations, keyed by the
+// length-prefixed delegator address.
+func (k Keeper) configsStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.ConfigKeyPrefix)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/autocompound/types"
+)
+
+// InitGenesis initializes the autocompound module's state from a genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, config := range genState.Configs {
+		k.SetConfig(ctx, config)
+	}
+}
+
+// ExportGenesis returns the autocompound module's genesis state. The cursor
+// of the compounding runs is not exported, so the runs restart at the first
+// delegator.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllConfigs(ctx))
+}
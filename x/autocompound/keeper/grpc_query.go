@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/autocompound/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{
+		Params:  k.GetParams(ctx),
+		Grantee: k.Grantee().String(),
+	}, nil
+}
+
+// Config implements the Query/Config gRPC method.
+func (k Keeper) Config(c context.Context, req *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	config, found := k.GetConfig(ctx, delegator)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "auto-compounding is not enabled for delegator %s", req.DelegatorAddress)
+	}
+
+	return &types.QueryConfigResponse{Config: config}, nil
+}
+
+// Configs implements the Query/Configs gRPC method.
+func (k Keeper) Configs(c context.Context, req *types.QueryConfigsRequest) (*types.QueryConfigsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var configs []types.Config
+	pageRes, err := query.Paginate(k.configsStore(ctx), req.Pagination, func(_, value []byte) error {
+		var config types.Config
+		if err := k.cdc.Unmarshal(value, &config); err != nil {
+			return err
+		}
+
+		configs = append(configs, config)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConfigsResponse{Configs: configs, Pagination: pageRes}, nil
+}
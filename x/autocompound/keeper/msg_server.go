@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/autocompound/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the autocompound MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// EnableAutoCompound implements the Msg/EnableAutoCompound method.
+func (k msgServer) EnableAutoCompound(goCtx context.Context, msg *types.MsgEnableAutoCompound) (*types.MsgEnableAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	config, found := k.GetConfig(ctx, delegator)
+	if !found {
+		config = types.NewConfig(delegator, msg.MinReward)
+	}
+	config.MinReward = msg.MinReward
+	k.SetConfig(ctx, config)
+
+	return &types.MsgEnableAutoCompoundResponse{}, nil
+}
+
+// DisableAutoCompound implements the Msg/DisableAutoCompound method.
+func (k msgServer) DisableAutoCompound(goCtx context.Context, msg *types.MsgDisableAutoCompound) (*types.MsgDisableAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetConfig(ctx, delegator); !found {
+		return nil, types.ErrNotEnabled.Wrapf("delegator %s", msg.DelegatorAddress)
+	}
+	k.DeleteConfig(ctx, delegator)
+
+	return &types.MsgDisableAutoCompoundResponse{}, nil
+}
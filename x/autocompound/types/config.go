@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewConfig creates a new Config instance.
+func NewConfig(delegator sdk.AccAddress, minReward sdk.Int) Config {
+	return Config{
+		DelegatorAddress: delegator.String(),
+		MinReward:        minReward,
+	}
+}
+
+// GetDelegatorAddr returns the address of the delegator.
+func (c Config) GetDelegatorAddr() sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(c.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return delegator
+}
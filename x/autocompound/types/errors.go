@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/autocompound errors
+var (
+	ErrNotEnabled      = sdkerrors.Register(ModuleName, 2, "auto-compounding is not enabled")
+	ErrWithdrawAddress = sdkerrors.Register(ModuleName, 3, "rewards are withdrawn to another address")
+)
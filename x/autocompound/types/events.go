@@ -0,0 +1,12 @@
+package types
+
+// autocompound module event types and attributes
+const (
+	EventTypeCompound       = "auto_compound"
+	EventTypeCompoundFailed = "auto_compound_failed"
+
+	AttributeKeyDelegator = "delegator"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyFee       = "fee"
+	AttributeKeyReason    = "reason"
+)
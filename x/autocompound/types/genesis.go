@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState instance.
+func NewGenesisState(params Params, configs []Config) *GenesisState {
+	return &GenesisState{
+		Params:  params,
+		Configs: configs,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the autocompound
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	delegators := make(map[string]bool, len(gs.Configs))
+	for _, config := range gs.Configs {
+		if _, err := sdk.AccAddressFromBech32(config.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid delegator address %s: %w", config.DelegatorAddress, err)
+		}
+		if delegators[config.DelegatorAddress] {
+			return fmt.Errorf("duplicate config for delegator %s", config.DelegatorAddress)
+		}
+		delegators[config.DelegatorAddress] = true
+
+		if config.MinReward.IsNil() || config.MinReward.IsNegative() {
+			return fmt.Errorf("negative min reward for delegator %s", config.DelegatorAddress)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the name of the autocompound module.
+	ModuleName = "autocompound"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key.
+	RouterKey = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// ConfigKeyPrefix prefixes the configurations of the delegators, by
+	// delegator address.
+	ConfigKeyPrefix = []byte{0x01}
+	// CursorKey holds the address of the delegator the next compounding run
+	// starts at.
+	CursorKey = []byte{0x02}
+)
+
+// ConfigKey returns the key of the configuration of a delegator.
+func ConfigKey(delegator []byte) []byte {
+	return append(ConfigKeyPrefix, address.MustLengthPrefix(delegator)...)
+}
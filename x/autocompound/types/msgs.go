@@ -0,0 +1,89 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// autocompound message types
+const (
+	TypeMsgEnableAutoCompound  = "enable_auto_compound"
+	TypeMsgDisableAutoCompound = "disable_auto_compound"
+)
+
+var (
+	_ sdk.Msg            = &MsgEnableAutoCompound{}
+	_ sdk.Msg            = &MsgDisableAutoCompound{}
+	_ legacytx.LegacyMsg = &MsgEnableAutoCompound{}
+	_ legacytx.LegacyMsg = &MsgDisableAutoCompound{}
+)
+
+// NewMsgEnableAutoCompound creates a new MsgEnableAutoCompound instance.
+func NewMsgEnableAutoCompound(delegator sdk.AccAddress, minReward sdk.Int) *MsgEnableAutoCompound {
+	return &MsgEnableAutoCompound{
+		DelegatorAddress: delegator.String(),
+		MinReward:        minReward,
+	}
+}
+
+// Route implements the legacytx.LegacyMsg interface.
+func (msg MsgEnableAutoCompound) Route() string { return RouterKey }
+
+// Type implements the legacytx.LegacyMsg interface.
+func (msg MsgEnableAutoCompound) Type() string { return TypeMsgEnableAutoCompound }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgEnableAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the legacytx.LegacyMsg interface.
+func (msg MsgEnableAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgEnableAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if msg.MinReward.IsNil() || msg.MinReward.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrap("min reward must not be negative")
+	}
+
+	return nil
+}
+
+// NewMsgDisableAutoCompound creates a new MsgDisableAutoCompound instance.
+func NewMsgDisableAutoCompound(delegator sdk.AccAddress) *MsgDisableAutoCompound {
+	return &MsgDisableAutoCompound{DelegatorAddress: delegator.String()}
+}
+
+// Route implements the legacytx.LegacyMsg interface.
+func (msg MsgDisableAutoCompound) Route() string { return RouterKey }
+
+// Type implements the legacytx.LegacyMsg interface.
+func (msg MsgDisableAutoCompound) Type() string { return TypeMsgDisableAutoCompound }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgDisableAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the legacytx.LegacyMsg interface.
+func (msg MsgDisableAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDisableAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	return nil
+}
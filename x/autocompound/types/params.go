@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"sigs.k8s.io/yaml"
+)
+
+// Default parameter values
+var (
+	// DefaultInterval compounds the rewards about once a day at 6s blocks.
+	DefaultInterval  uint64 = 14400
+	DefaultMaxGas    uint64 = 20_000_000
+	DefaultMinReward        = sdk.NewInt(10_000)
+	DefaultFee              = sdk.NewInt(1_000)
+)
+
+// Parameter store keys
+var (
+	KeyInterval  = []byte("Interval")
+	KeyMaxGas    = []byte("MaxGas")
+	KeyMinReward = []byte("MinReward")
+	KeyFee       = []byte("Fee")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table of the autocompound module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(interval, maxGas uint64, minReward, fee sdk.Int) Params {
+	return Params{
+		Interval:  interval,
+		MaxGas:    maxGas,
+		MinReward: minReward,
+		Fee:       fee,
+	}
+}
+
+// DefaultParams returns the default parameters of the autocompound module.
+func DefaultParams() Params {
+	return NewParams(DefaultInterval, DefaultMaxGas, DefaultMinReward, DefaultFee)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyInterval, &p.Interval, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxGas, &p.MaxGas, validateUint64),
+		paramtypes.NewParamSetPair(KeyMinReward, &p.MinReward, validateAmount),
+		paramtypes.NewParamSetPair(KeyFee, &p.Fee, validateAmount),
+	}
+}
+
+// Validate validates the parameters.
+func (p Params) Validate() error {
+	if err := validateAmount(p.MinReward); err != nil {
+		return fmt.Errorf("invalid min reward: %w", err)
+	}
+	if err := validateAmount(p.Fee); err != nil {
+		return fmt.Errorf("invalid fee: %w", err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAmount(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("amount must not be negative: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,909 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/autocompound/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgEnableAutoCompound is the Msg/EnableAutoCompound request type.
+type MsgEnableAutoCompound struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// min_reward is the minimum amount of bond denom rewards to compound, on
+	// top of the min_reward parameter.
+	MinReward github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_reward,json=minReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_reward"`
+}
+
+func (m *MsgEnableAutoCompound) Reset()         { *m = MsgEnableAutoCompound{} }
+func (m *MsgEnableAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableAutoCompound) ProtoMessage()    {}
+func (*MsgEnableAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958a5a69529788f4, []int{0}
+}
+func (m *MsgEnableAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableAutoCompound.Merge(m, src)
+}
+func (m *MsgEnableAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableAutoCompound proto.InternalMessageInfo
+
+// MsgEnableAutoCompoundResponse is the Msg/EnableAutoCompound response type.
+type MsgEnableAutoCompoundResponse struct {
+}
+
+func (m *MsgEnableAutoCompoundResponse) Reset()         { *m = MsgEnableAutoCompoundResponse{} }
+func (m *MsgEnableAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgEnableAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958a5a69529788f4, []int{1}
+}
+func (m *MsgEnableAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgEnableAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableAutoCompoundResponse proto.InternalMessageInfo
+
+// MsgDisableAutoCompound is the Msg/DisableAutoCompound request type.
+type MsgDisableAutoCompound struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *MsgDisableAutoCompound) Reset()         { *m = MsgDisableAutoCompound{} }
+func (m *MsgDisableAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableAutoCompound) ProtoMessage()    {}
+func (*MsgDisableAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958a5a69529788f4, []int{2}
+}
+func (m *MsgDisableAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisableAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableAutoCompound.Merge(m, src)
+}
+func (m *MsgDisableAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisableAutoCompound proto.InternalMessageInfo
+
+// MsgDisableAutoCompoundResponse is the Msg/DisableAutoCompound response type.
+type MsgDisableAutoCompoundResponse struct {
+}
+
+func (m *MsgDisableAutoCompoundResponse) Reset()         { *m = MsgDisableAutoCompoundResponse{} }
+func (m *MsgDisableAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgDisableAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958a5a69529788f4, []int{3}
+}
+func (m *MsgDisableAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisableAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgDisableAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisableAutoCompoundResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgEnableAutoCompound)(nil), "gaia.autocompound.v1beta1.MsgEnableAutoCompound")
+	proto.RegisterType((*MsgEnableAutoCompoundResponse)(nil), "gaia.autocompound.v1beta1.MsgEnableAutoCompoundResponse")
+	proto.RegisterType((*MsgDisableAutoCompound)(nil), "gaia.autocompound.v1beta1.MsgDisableAutoCompound")
+	proto.RegisterType((*MsgDisableAutoCompoundResponse)(nil), "gaia.autocompound.v1beta1.MsgDisableAutoCompoundResponse")
+}
+
+func init() {
+	proto.RegisterFile("gaia/autocompound/v1beta1/tx.proto", fileDescriptor_958a5a69529788f4)
+}
+
+var fileDescriptor_958a5a69529788f4 = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x3f, 0x4b, 0xc3, 0x40,
+	0x1c, 0xcd, 0x55, 0x10, 0x7b, 0x93, 0xc6, 0x3f, 0xd4, 0x82, 0x49, 0xc9, 0x20, 0x82, 0x78, 0x67,
+	0x75, 0xa9, 0x6e, 0xad, 0x0a, 0x3a, 0x04, 0x21, 0xa3, 0x4b, 0xb9, 0x34, 0xc7, 0x19, 0x6c, 0xf2,
+	0x0b, 0xb9, 0x4b, 0xad, 0x9b, 0xe0, 0xe2, 0x24, 0x7e, 0x01, 0xa1, 0x1f, 0xa7, 0x63, 0x47, 0x71,
+	0x28, 0xd2, 0x2e, 0x7e, 0x0c, 0x69, 0xda, 0x4a, 0xc5, 0x28, 0x14, 0xa7, 0x84, 0xbb, 0xf7, 0xde,
+	0xef, 0xbd, 0xfb, 0x3d, 0x6c, 0x09, 0xe6, 0x33, 0xca, 0x12, 0x05, 0x0d, 0x08, 0x22, 0x48, 0x42,
+	0x8f, 0xb6, 0xca, 0x2e, 0x57, 0xac, 0x4c, 0x55, 0x9b, 0x44, 0x31, 0x28, 0xd0, 0x37, 0x47, 0x18,
+	0x32, 0x8b, 0x21, 0x13, 0x4c, 0x71, 0x4d, 0x80, 0x80, 0x14, 0x45, 0x47, 0x7f, 0x63, 0x82, 0xf5,
+	0x82, 0xf0, 0xba, 0x2d, 0xc5, 0x59, 0xc8, 0xdc, 0x26, 0xaf, 0x26, 0x0a, 0x4e, 0x26, 0x3c, 0x7d,
+	0x17, 0xaf, 0x78, 0xbc, 0xc9, 0x05, 0x53, 0x10, 0xd7, 0x99, 0xe7, 0xc5, 0x5c, 0xca, 0x02, 0x2a,
+	0xa1, 0x9d, 0xbc, 0xb3, 0xfc, 0x75, 0x51, 0x1d, 0x9f, 0xeb, 0x36, 0xc6, 0x81, 0x1f, 0xd6, 0x63,
+	0x7e, 0xcb, 0x62, 0xaf, 0x90, 0x1b, 0xa1, 0x6a, 0xa4, 0xdb, 0x37, 0xb5, 0xb7, 0xbe, 0xb9, 0x2d,
+	0x7c, 0x75, 0x9d, 0xb8, 0xa4, 0x01, 0x01, 0x6d, 0x80, 0x0c, 0x40, 0x4e, 0x3e, 0x7b, 0xd2, 0xbb,
+	0xa1, 0xea, 0x2e, 0xe2, 0x92, 0x5c, 0x84, 0xca, 0xc9, 0x07, 0x7e, 0xe8, 0xa4, 0x02, 0xc7, 0x4b,
+	0x8f, 0x1d, 0x53, 0xfb, 0xe8, 0x98, 0x9a, 0x65, 0xe2, 0xad, 0x4c, 0x7b, 0x0e, 0x97, 0x11, 0x84,
+	0x92, 0x5b, 0x97, 0x78, 0xc3, 0x96, 0xe2, 0xd4, 0x97, 0xff, 0x0a, 0x30, 0x33, 0xb1, 0x84, 0x8d,
+	0x6c, 0xc1, 0xe9, 0xc8, 0x83, 0xa7, 0x1c, 0x5e, 0xb0, 0xa5, 0xd0, 0xef, 0x11, 0xd6, 0x33, 0x1e,
+	0x6e, 0x9f, 0xfc, 0xba, 0x04, 0x92, 0x99, 0xa5, 0x58, 0x99, 0x97, 0x31, 0xb5, 0xa2, 0x3f, 0x20,
+	0xbc, 0x9a, 0x95, 0xbd, 0xfc, 0xb7, 0x62, 0x06, 0xa5, 0x78, 0x34, 0x37, 0x65, 0xea, 0xa2, 0x76,
+	0xde, 0x1d, 0x18, 0xa8, 0x37, 0x30, 0xd0, 0xfb, 0xc0, 0x40, 0xcf, 0x43, 0x43, 0xeb, 0x0d, 0x0d,
+	0xed, 0x75, 0x68, 0x68, 0x57, 0xe4, 0xe7, 0xee, 0xd3, 0x16, 0xb7, 0x2a, 0xb4, 0xfd, 0xbd, 0xca,
+	0x69, 0x0f, 0xdc, 0xc5, 0xb4, 0x95, 0x87, 0x9f, 0x03, 0x00, 0x59, 0x1a, 0x84, 0x62, 0xec, 0x02,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// EnableAutoCompound opts a delegator in to the auto-compounding of its
+	// rewards, or updates its configuration.
+	EnableAutoCompound(ctx context.Context, in *MsgEnableAutoCompound, opts ...grpc.CallOption) (*MsgEnableAutoCompoundResponse, error)
+	// DisableAutoCompound opts a delegator out of the auto-compounding of its
+	// rewards.
+	DisableAutoCompound(ctx context.Context, in *MsgDisableAutoCompound, opts ...grpc.CallOption) (*MsgDisableAutoCompoundResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) EnableAutoCompound(ctx context.Context, in *MsgEnableAutoCompound, opts ...grpc.CallOption) (*MsgEnableAutoCompoundResponse, error) {
+	out := new(MsgEnableAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/gaia.autocompound.v1beta1.Msg/EnableAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableAutoCompound(ctx context.Context, in *MsgDisableAutoCompound, opts ...grpc.CallOption) (*MsgDisableAutoCompoundResponse, error) {
+	out := new(MsgDisableAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/gaia.autocompound.v1beta1.Msg/DisableAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// EnableAutoCompound opts a delegator in to the auto-compounding of its
+	// rewards, or updates its configuration.
+	EnableAutoCompound(context.Context, *MsgEnableAutoCompound) (*MsgEnableAutoCompoundResponse, error)
+	// DisableAutoCompound opts a delegator out of the auto-compounding of its
+	// rewards.
+	DisableAutoCompound(context.Context, *MsgDisableAutoCompound) (*MsgDisableAutoCompoundResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) EnableAutoCompound(ctx context.Context, req *MsgEnableAutoCompound) (*MsgEnableAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableAutoCompound not implemented")
+}
+func (*UnimplementedMsgServer) DisableAutoCompound(ctx context.Context, req *MsgDisableAutoCompound) (*MsgDisableAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableAutoCompound not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_EnableAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.autocompound.v1beta1.Msg/EnableAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableAutoCompound(ctx, req.(*MsgEnableAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.autocompound.v1beta1.Msg/DisableAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableAutoCompound(ctx, req.(*MsgDisableAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.autocompound.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EnableAutoCompound",
+			Handler:    _Msg_EnableAutoCompound_Handler,
+		},
+		{
+			MethodName: "DisableAutoCompound",
+			Handler:    _Msg_DisableAutoCompound_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/autocompound/v1beta1/tx.proto",
+}
+
+func (m *MsgEnableAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinReward.Size()
+		i -= size
+		if _, err := m.MinReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEnableAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinReward.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgEnableAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgEnableAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/denomfilter/types"
+)
+
+// GetQueryCmd returns the cli query commands for the denomfilter module.
+func GetQueryCmd() *cobra.Command {
+	denomfilterQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the denomfilter module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	denomfilterQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryChannelFilter(),
+		GetCmdQueryDenomAllowed(),
+	)
+
+	return denomfilterQueryCmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the denom filters of the IBC transfer channels",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryChannelFilter implements the channel filter query command.
+func GetCmdQueryChannelFilter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel [channel-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the denom filter of an IBC transfer channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelFilter(cmd.Context(), &types.QueryChannelFilterRequest{ChannelId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDenomAllowed implements the denom allowed query command.
+func GetCmdQueryDenomAllowed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowed [channel-id] [denom-trace]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query whether a denom trace, e.g. transfer/channel-0/uatom, is allowed through an IBC transfer channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomAllowed(cmd.Context(), &types.QueryDenomAllowedRequest{
+				ChannelId: args[0],
+				Denom:     args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,46 @@
+package denomfilter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/cosmos/gaia/v8/x/denomfilter/keeper"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the transfer IBC module to reject the received packets
+// of the denoms not allowed through their channel. The sent packets are
+// checked by the keeper wrapping the ICS4 wrapper of the transfer keeper. All
+// callbacks other than OnRecvPacket are passed through to the wrapped module.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the given transfer
+// IBC module.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. A packet of a denom not
+// allowed through its channel is rejected with an error acknowledgement
+// describing why, so that the tokens are refunded on the sender chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	if err := im.keeper.CheckReceive(ctx, packet); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
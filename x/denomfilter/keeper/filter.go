@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// SendPacket implements the ICS4Wrapper interface. A transfer packet of a
+// denom not allowed through its channel is not sent.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	if err := k.checkTransfer(ctx, packet.GetSourceChannel(), packet, false); err != nil {
+		return err
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// CheckReceive returns an error if the denom of a received transfer packet is
+// not allowed through its channel. The denom is checked as it is traced on
+// the Hub once received.
+func (k Keeper) CheckReceive(ctx sdk.Context, packet exported.PacketI) error {
+	return k.checkTransfer(ctx, packet.GetDestChannel(), packet, true)
+}
+
+// CheckDenom returns an error if a denom trace is not allowed through a
+// channel.
+func (k Keeper) CheckDenom(ctx sdk.Context, channelID, denom string) error {
+	filter, found := k.GetParams(ctx).ChannelFilter(channelID)
+	if !found {
+		return nil
+	}
+
+	return filter.CheckDenom(denom)
+}
+
+// checkTransfer checks the denom of a sent or received transfer packet
+// against the filter of a channel, passing the other packets through.
+func (k Keeper) checkTransfer(ctx sdk.Context, channelID string, packet exported.PacketI, received bool) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil
+	}
+
+	denom := data.Denom
+	if received {
+		denom = receivedDenom(packet, data.Denom)
+	}
+	return k.CheckDenom(ctx, channelID, denom)
+}
+
+// receivedDenom returns the denom trace on the Hub of the tokens received in
+// a transfer packet, as the transfer module computes it.
+func receivedDenom(packet exported.PacketI, packetDenom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), packetDenom) {
+		// the tokens return to the Hub, stripped of the prefix the Hub added
+		return packetDenom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+	}
+
+	return transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + packetDenom
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v8/app/helpers"
+	"github.com/cosmos/gaia/v8/x/denomfilter"
+	"github.com/cosmos/gaia/v8/x/denomfilter/keeper"
+	"github.com/cosmos/gaia/v8/x/denomfilter/types"
+)
+
+// channelKeeper is an ICS4 wrapper recording the sent packets.
+type channelKeeper struct {
+	sent *[]exported.PacketI
+}
+
+func (ck channelKeeper) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, packet exported.PacketI) error {
+	*ck.sent = append(*ck.sent, packet)
+	return nil
+}
+
+func (channelKeeper) WriteAcknowledgement(sdk.Context, *capabilitytypes.Capability, exported.PacketI, exported.Acknowledgement) error {
+	return nil
+}
+
+// transferModule is a transfer IBC module acknowledging every received
+// packet.
+type transferModule struct {
+	porttypes.IBCModule
+}
+
+func (transferModule) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) exported.Acknowledgement {
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func transferPacket(srcChannel, dstChannel, denom string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, "100", "sender", "receiver")
+	return channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, srcChannel, transfertypes.PortID, dstChannel, clienttypes.NewHeight(0, 100), 0)
+}
+
+func TestDenomFilter(t *testing.T) {
+	app := gaiahelpers.Setup(t, false, 0)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	var sent []exported.PacketI
+	k := keeper.NewKeeper(app.GetSubspace(types.ModuleName), channelKeeper{&sent})
+	middleware := denomfilter.NewIBCMiddleware(transferModule{}, k)
+
+	// channel-0 only allows atoms and the osmo tokens from the counterparty,
+	// channel-1 blocks the tokens the counterparty received through its
+	// channel-5, as they are traced on the Hub
+	k.SetParams(ctx, types.NewParams([]types.ChannelFilter{
+		{
+			ChannelId: "channel-0",
+			Allowlist: types.DenomList{BaseDenoms: []string{"uatom", "uosmo"}},
+			Blocklist: types.DenomList{TracePrefixes: []string{"transfer/channel-9"}},
+		},
+		{
+			ChannelId: "channel-1",
+			Blocklist: types.DenomList{TracePrefixes: []string{"transfer/channel-1/transfer/channel-5"}},
+		},
+	}))
+
+	require.NoError(t, k.SendPacket(ctx, nil, transferPacket("channel-0", "channel-7", "uatom")))
+	require.NoError(t, k.SendPacket(ctx, nil, transferPacket("channel-0", "channel-7", "transfer/channel-0/uosmo")))
+	err := k.SendPacket(ctx, nil, transferPacket("channel-0", "channel-7", "transfer/channel-2/ujuno"))
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+	err = k.SendPacket(ctx, nil, transferPacket("channel-0", "channel-7", "transfer/channel-9/uosmo"))
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+	require.NoError(t, k.SendPacket(ctx, nil, transferPacket("channel-2", "channel-8", "transfer/channel-2/ujuno")))
+	require.Len(t, sent, 3)
+
+	// the received packets are matched against their trace on the Hub
+	ack := middleware.OnRecvPacket(ctx, transferPacket("channel-7", "channel-0", "uosmo"), nil)
+	require.True(t, ack.Success())
+	ack = middleware.OnRecvPacket(ctx, transferPacket("channel-3", "channel-1", "transfer/channel-5/uspam"), nil)
+	require.False(t, ack.Success())
+	require.Contains(t, string(ack.Acknowledgement()), "transfer/channel-1/transfer/channel-5/uspam is in the blocklist of channel-1")
+	ack = middleware.OnRecvPacket(ctx, transferPacket("channel-3", "channel-1", "transfer/channel-50/uspam"), nil)
+	require.True(t, ack.Success())
+
+	// the tokens returning to the Hub are stripped of the prefix the Hub
+	// added when sending them
+	ack = middleware.OnRecvPacket(ctx, transferPacket("channel-7", "channel-0", "transfer/channel-7/uatom"), nil)
+	require.True(t, ack.Success())
+	ack = middleware.OnRecvPacket(ctx, transferPacket("channel-7", "channel-0", "transfer/channel-7/transfer/channel-9/uosmo"), nil)
+	require.False(t, ack.Success())
+	require.Contains(t, string(ack.Acknowledgement()), "transfer/channel-9/uosmo is in the blocklist of channel-0")
+
+	res, err := k.DenomAllowed(sdk.WrapSDKContext(ctx), &types.QueryDenomAllowedRequest{ChannelId: "channel-0", Denom: "ujuno"})
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Contains(t, res.Reason, "not in the allowlist of channel-0")
+	res, err = k.DenomAllowed(sdk.WrapSDKContext(ctx), &types.QueryDenomAllowedRequest{ChannelId: "channel-4", Denom: "ujuno"})
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+
+	// the trace prefixes must be port/channel pairs
+	invalid := types.NewParams([]types.ChannelFilter{{ChannelId: "channel-0", Blocklist: types.DenomList{TracePrefixes: []string{"transfer"}}}})
+	require.Error(t, invalid.Validate())
+}
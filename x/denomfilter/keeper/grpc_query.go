@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/denomfilter/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// ChannelFilter implements the Query/ChannelFilter gRPC method.
+func (k Keeper) ChannelFilter(c context.Context, req *types.QueryChannelFilterRequest) (*types.QueryChannelFilterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	filter, found := k.GetParams(ctx).ChannelFilter(req.ChannelId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "channel %s is not filtered", req.ChannelId)
+	}
+
+	return &types.QueryChannelFilterResponse{Filter: filter}, nil
+}
+
+// DenomAllowed implements the Query/DenomAllowed gRPC method.
+func (k Keeper) DenomAllowed(c context.Context, req *types.QueryDenomAllowedRequest) (*types.QueryDenomAllowedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := k.CheckDenom(ctx, req.ChannelId, req.Denom); err != nil {
+		return &types.QueryDenomAllowedResponse{Allowed: false, Reason: err.Error()}, nil
+	}
+
+	return &types.QueryDenomAllowedResponse{Allowed: true}, nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/gaia/v8/x/denomfilter/types"
+)
+
+// Keeper of the denomfilter module, whose filters are its parameters.
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+
+	ics4Wrapper types.ICS4Wrapper
+}
+
+// NewKeeper creates a new denomfilter Keeper instance. The filtered packets
+// are sent and acknowledged through the ICS4 wrapper.
+func NewKeeper(paramSpace paramtypes.Subspace, ics4Wrapper types.ICS4Wrapper) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace:  paramSpace,
+		ics4Wrapper: ics4Wrapper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the parameters of the module.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the parameters of the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
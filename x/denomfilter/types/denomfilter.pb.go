@@ -0,0 +1,860 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/denomfilter/v1beta1/denomfilter.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the denomfilter module.
+type Params struct {
+	// channel_filters are the filters of the denoms transferred through the
+	// channels.
+	ChannelFilters []ChannelFilter `protobuf:"bytes,1,rep,name=channel_filters,json=channelFilters,proto3" json:"channel_filters"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c7366ee80b88004, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetChannelFilters() []ChannelFilter {
+	if m != nil {
+		return m.ChannelFilters
+	}
+	return nil
+}
+
+// ChannelFilter filters the denoms of the ICS-20 packets sent and received
+// through a channel. A denom is matched against the denom trace of the packet,
+// which is the trace on the chain sending the packet. A denom matching the
+// blocklist is rejected, as is a denom not matching a non-empty allowlist.
+type ChannelFilter struct {
+	// channel_id is the id of the channel on the Hub.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// allowlist are the denoms allowed through the channel. All the denoms
+	// are allowed if it is empty.
+	Allowlist DenomList `protobuf:"bytes,2,opt,name=allowlist,proto3" json:"allowlist"`
+	// blocklist are the denoms blocked through the channel.
+	Blocklist DenomList `protobuf:"bytes,3,opt,name=blocklist,proto3" json:"blocklist"`
+}
+
+func (m *ChannelFilter) Reset()         { *m = ChannelFilter{} }
+func (m *ChannelFilter) String() string { return proto.CompactTextString(m) }
+func (*ChannelFilter) ProtoMessage()    {}
+func (*ChannelFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c7366ee80b88004, []int{1}
+}
+func (m *ChannelFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFilter.Merge(m, src)
+}
+func (m *ChannelFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFilter proto.InternalMessageInfo
+
+func (m *ChannelFilter) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelFilter) GetAllowlist() DenomList {
+	if m != nil {
+		return m.Allowlist
+	}
+	return DenomList{}
+}
+
+func (m *ChannelFilter) GetBlocklist() DenomList {
+	if m != nil {
+		return m.Blocklist
+	}
+	return DenomList{}
+}
+
+// DenomList is a list of denoms, matched by base denom or by trace prefix.
+type DenomList struct {
+	// base_denoms match the denoms with these base denoms, whatever their
+	// trace.
+	BaseDenoms []string `protobuf:"bytes,1,rep,name=base_denoms,json=baseDenoms,proto3" json:"base_denoms,omitempty"`
+	// trace_prefixes match the denoms whose trace path starts with these
+	// port/channel pairs, e.g. transfer/channel-0.
+	TracePrefixes []string `protobuf:"bytes,2,rep,name=trace_prefixes,json=tracePrefixes,proto3" json:"trace_prefixes,omitempty"`
+}
+
+func (m *DenomList) Reset()         { *m = DenomList{} }
+func (m *DenomList) String() string { return proto.CompactTextString(m) }
+func (*DenomList) ProtoMessage()    {}
+func (*DenomList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c7366ee80b88004, []int{2}
+}
+func (m *DenomList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomList.Merge(m, src)
+}
+func (m *DenomList) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomList) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomList proto.InternalMessageInfo
+
+func (m *DenomList) GetBaseDenoms() []string {
+	if m != nil {
+		return m.BaseDenoms
+	}
+	return nil
+}
+
+func (m *DenomList) GetTracePrefixes() []string {
+	if m != nil {
+		return m.TracePrefixes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gaia.denomfilter.v1beta1.Params")
+	proto.RegisterType((*ChannelFilter)(nil), "gaia.denomfilter.v1beta1.ChannelFilter")
+	proto.RegisterType((*DenomList)(nil), "gaia.denomfilter.v1beta1.DenomList")
+}
+
+func init() {
+	proto.RegisterFile("gaia/denomfilter/v1beta1/denomfilter.proto", fileDescriptor_6c7366ee80b88004)
+}
+
+var fileDescriptor_6c7366ee80b88004 = []byte{
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x4b, 0x32, 0x41,
+	0x18, 0xc7, 0x77, 0x54, 0x84, 0x1d, 0xd1, 0x17, 0x86, 0xf7, 0xb0, 0x04, 0x8d, 0x62, 0x44, 0x12,
+	0xb4, 0x83, 0x76, 0x89, 0x8e, 0x16, 0x49, 0xd0, 0x41, 0x0c, 0x3a, 0x74, 0x91, 0xd9, 0x71, 0x5c,
+	0x87, 0x66, 0x1d, 0xd9, 0x99, 0xcc, 0xbe, 0x45, 0xc7, 0x8e, 0x7d, 0x94, 0x8e, 0x1e, 0x3d, 0x76,
+	0x8a, 0xd0, 0x2f, 0x12, 0x3b, 0xbb, 0x99, 0x1e, 0x3c, 0x74, 0x5b, 0x7e, 0xfb, 0xfb, 0xff, 0xe7,
+	0x61, 0xe6, 0x81, 0xc7, 0x21, 0x15, 0x94, 0x0c, 0xf8, 0x58, 0x45, 0x43, 0x21, 0x0d, 0x8f, 0xc9,
+	0xb4, 0x19, 0x70, 0x43, 0x9b, 0x9b, 0xcc, 0x9f, 0xc4, 0xca, 0x28, 0xe4, 0x25, 0xae, 0xbf, 0xc9,
+	0x33, 0x77, 0xef, 0x7f, 0xa8, 0x42, 0x65, 0x25, 0x92, 0x7c, 0xa5, 0x7e, 0x7d, 0x08, 0x8b, 0x5d,
+	0x1a, 0xd3, 0x48, 0xa3, 0x3b, 0xf8, 0x8f, 0x8d, 0xe8, 0x78, 0xcc, 0x65, 0x3f, 0x4d, 0x6a, 0x0f,
+	0xd4, 0xf2, 0x8d, 0x52, 0xeb, 0xc8, 0xdf, 0xd5, 0xe9, 0x5f, 0xa4, 0x81, 0x2b, 0x4b, 0xdb, 0x85,
+	0xf9, 0x67, 0xd5, 0xe9, 0x55, 0xd8, 0x26, 0xd4, 0xe7, 0x85, 0xd7, 0xb7, 0xaa, 0x53, 0x7f, 0x07,
+	0xb0, 0xbc, 0x65, 0xa3, 0x7d, 0x08, 0x7f, 0xce, 0x13, 0x03, 0x0f, 0xd4, 0x40, 0xc3, 0xed, 0xb9,
+	0x19, 0xb9, 0x1e, 0xa0, 0x0e, 0x74, 0xa9, 0x94, 0xea, 0x49, 0x0a, 0x6d, 0xbc, 0x5c, 0x0d, 0x34,
+	0x4a, 0xad, 0x83, 0xdd, 0x83, 0x5c, 0x26, 0xec, 0x46, 0x68, 0x93, 0x0d, 0xf1, 0x9b, 0x4d, 0x8a,
+	0x02, 0xa9, 0xd8, 0x83, 0x2d, 0xca, 0xff, 0xb9, 0x68, 0x9d, 0xad, 0xdf, 0x42, 0x77, 0xfd, 0x17,
+	0x55, 0x61, 0x29, 0xa0, 0x9a, 0xf7, 0x6d, 0x47, 0x7a, 0x53, 0x6e, 0x0f, 0x26, 0xc8, 0x3a, 0x1a,
+	0x1d, 0xc2, 0x8a, 0x89, 0x29, 0xe3, 0xfd, 0x49, 0xcc, 0x87, 0x62, 0xc6, 0xb5, 0x97, 0xb3, 0x4e,
+	0xd9, 0xd2, 0x6e, 0x06, 0xdb, 0x9d, 0xf9, 0x12, 0x83, 0xc5, 0x12, 0x83, 0xaf, 0x25, 0x06, 0x2f,
+	0x2b, 0xec, 0x2c, 0x56, 0xd8, 0xf9, 0x58, 0x61, 0xe7, 0xfe, 0x24, 0x14, 0x66, 0xf4, 0x18, 0xf8,
+	0x4c, 0x45, 0x84, 0x29, 0x1d, 0x29, 0x4d, 0xec, 0x1e, 0x4c, 0xcf, 0xc8, 0x6c, 0x6b, 0x19, 0xcc,
+	0xf3, 0x84, 0xeb, 0xa0, 0x68, 0xdf, 0xf3, 0xf4, 0x7b, 0x00, 0x44, 0xa1, 0x5d, 0xd9, 0x2d, 0x02,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelFilters) > 0 {
+		for iNdEx := len(m.ChannelFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDenomfilter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Blocklist.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDenomfilter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Allowlist.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDenomfilter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintDenomfilter(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TracePrefixes) > 0 {
+		for iNdEx := len(m.TracePrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TracePrefixes[iNdEx])
+			copy(dAtA[i:], m.TracePrefixes[iNdEx])
+			i = encodeVarintDenomfilter(dAtA, i, uint64(len(m.TracePrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BaseDenoms) > 0 {
+		for iNdEx := len(m.BaseDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BaseDenoms[iNdEx])
+			copy(dAtA[i:], m.BaseDenoms[iNdEx])
+			i = encodeVarintDenomfilter(dAtA, i, uint64(len(m.BaseDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDenomfilter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDenomfilter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelFilters) > 0 {
+		for _, e := range m.ChannelFilters {
+			l = e.Size()
+			n += 1 + l + sovDenomfilter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovDenomfilter(uint64(l))
+	}
+	l = m.Allowlist.Size()
+	n += 1 + l + sovDenomfilter(uint64(l))
+	l = m.Blocklist.Size()
+	n += 1 + l + sovDenomfilter(uint64(l))
+	return n
+}
+
+func (m *DenomList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BaseDenoms) > 0 {
+		for _, s := range m.BaseDenoms {
+			l = len(s)
+			n += 1 + l + sovDenomfilter(uint64(l))
+		}
+	}
+	if len(m.TracePrefixes) > 0 {
+		for _, s := range m.TracePrefixes {
+			l = len(s)
+			n += 1 + l + sovDenomfilter(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDenomfilter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDenomfilter(x uint64) (n int) {
+	return sovDenomfilter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelFilters = append(m.ChannelFilters, ChannelFilter{})
+			if err := m.ChannelFilters[len(m.ChannelFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocklist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Blocklist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenoms = append(m.BaseDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TracePrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TracePrefixes = append(m.TracePrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDenomfilter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDenomfilter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDenomfilter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDenomfilter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDenomfilter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDenomfilter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDenomfilter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDenomfilter = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/denomfilter errors
+var (
+	ErrDenomNotAllowed = sdkerrors.Register(ModuleName, 2, "denom not allowed through channel")
+)
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// CheckDenom returns an error if a denom trace is not allowed through the
+// channel of the filter.
+func (f ChannelFilter) CheckDenom(denom string) error {
+	trace := transfertypes.ParseDenomTrace(denom)
+	if f.Blocklist.Matches(trace) {
+		return sdkerrors.Wrapf(ErrDenomNotAllowed, "%s is in the blocklist of %s", denom, f.ChannelId)
+	}
+	if !f.Allowlist.IsEmpty() && !f.Allowlist.Matches(trace) {
+		return sdkerrors.Wrapf(ErrDenomNotAllowed, "%s is not in the allowlist of %s", denom, f.ChannelId)
+	}
+
+	return nil
+}
+
+// IsEmpty returns whether the list matches no denom.
+func (l DenomList) IsEmpty() bool {
+	return len(l.BaseDenoms) == 0 && len(l.TracePrefixes) == 0
+}
+
+// Matches returns whether a denom trace matches the list, either by its base
+// denom or by the prefix of its path.
+func (l DenomList) Matches(trace transfertypes.DenomTrace) bool {
+	for _, baseDenom := range l.BaseDenoms {
+		if trace.BaseDenom == baseDenom {
+			return true
+		}
+	}
+	for _, prefix := range l.TracePrefixes {
+		if trace.Path == prefix || strings.HasPrefix(trace.Path, prefix+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate validates the list.
+func (l DenomList) Validate() error {
+	for _, baseDenom := range l.BaseDenoms {
+		if strings.TrimSpace(baseDenom) == "" {
+			return fmt.Errorf("base denom cannot be blank")
+		}
+	}
+	for _, prefix := range l.TracePrefixes {
+		if prefix == "" {
+			return fmt.Errorf("trace prefix cannot be empty")
+		}
+		// the prefix must be a valid path of port/channel pairs
+		if err := (transfertypes.DenomTrace{Path: prefix, BaseDenom: "denom"}).Validate(); err != nil {
+			return fmt.Errorf("invalid trace prefix %q: %w", prefix, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package types
+
+// NewGenesisState creates a new GenesisState instance.
+func NewGenesisState(params Params) *GenesisState {
+	return &GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the denomfilter
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
@@ -0,0 +1,7 @@
+package types
+
+const (
+	// ModuleName defines the name of the denomfilter module, whose filters
+	// are its parameters and which has no store of its own.
+	ModuleName = "denomfilter"
+)
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"sigs.k8s.io/yaml"
+)
+
+// Parameter store keys
+var (
+	KeyChannelFilters = []byte("ChannelFilters")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table of the denomfilter module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(channelFilters []ChannelFilter) Params {
+	return Params{
+		ChannelFilters: channelFilters,
+	}
+}
+
+// DefaultParams returns the default parameters of the denomfilter module,
+// which filter no channel.
+func DefaultParams() Params {
+	return NewParams(nil)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyChannelFilters, &p.ChannelFilters, validateChannelFilters),
+	}
+}
+
+// Validate validates the parameters.
+func (p Params) Validate() error {
+	if err := validateChannelFilters(p.ChannelFilters); err != nil {
+		return fmt.Errorf("invalid channel filters: %w", err)
+	}
+
+	return nil
+}
+
+// ChannelFilter returns the filter of a channel, if any.
+func (p Params) ChannelFilter(channelID string) (ChannelFilter, bool) {
+	for _, filter := range p.ChannelFilters {
+		if filter.ChannelId == channelID {
+			return filter, true
+		}
+	}
+
+	return ChannelFilter{}, false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateChannelFilters(i interface{}) error {
+	v, ok := i.([]ChannelFilter)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	channels := make(map[string]bool, len(v))
+	for _, filter := range v {
+		if err := host.ChannelIdentifierValidator(filter.ChannelId); err != nil {
+			return err
+		}
+		if channels[filter.ChannelId] {
+			return fmt.Errorf("duplicate filter of channel %s", filter.ChannelId)
+		}
+		channels[filter.ChannelId] = true
+
+		if err := filter.Allowlist.Validate(); err != nil {
+			return fmt.Errorf("invalid allowlist of channel %s: %w", filter.ChannelId, err)
+		}
+		if err := filter.Blocklist.Validate(); err != nil {
+			return fmt.Errorf("invalid blocklist of channel %s: %w", filter.ChannelId, err)
+		}
+	}
+
+	return nil
+}
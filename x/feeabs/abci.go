@@ -0,0 +1,18 @@
+package feeabs
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/feeabs/keeper"
+	"github.com/cosmos/gaia/v8/x/feeabs/types"
+)
+
+// BeginBlocker records the prices of the fee denoms.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.RecordPrices(ctx)
+}
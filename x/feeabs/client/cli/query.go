@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/feeabs/types"
+)
+
+// GetQueryCmd returns the cli query commands for the feeabs module.
+func GetQueryCmd() *cobra.Command {
+	feeabsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feeabs module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feeabsQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryPrice(),
+		GetCmdQueryPrices(),
+	)
+
+	return feeabsQueryCmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the fee abstraction parameters",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPrice implements the price query command.
+func GetCmdQueryPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the price in the native denom of a denom accepted to pay fees",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeDenomPrice(cmd.Context(), &types.QueryFeeDenomPriceRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPrices implements the prices query command.
+func GetCmdQueryPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prices",
+		Args:  cobra.NoArgs,
+		Short: "Query the prices in the native denom of all the denoms accepted to pay fees",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeDenomPrices(cmd.Context(), &types.QueryFeeDenomPricesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/feeabs/types"
+)
+
+// escrowDestinations are the destinations of the escrowed fees, by their
+// command line name.
+var escrowDestinations = map[string]types.EscrowDestination{
+	"fee-collector":  types.EscrowDestinationFeeCollector,
+	"community-pool": types.EscrowDestinationCommunityPool,
+}
+
+// NewCmdSubmitReleaseEscrowedFeesProposal implements the command submitting a
+// release escrowed fees proposal.
+func NewCmdSubmitReleaseEscrowedFeesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-escrowed-fees [fee-collector|community-pool]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal releasing the fees escrowed in the fee denoms",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal releasing the fees paid in the fee denoms, which are
+escrowed in the feeabs module account, along with an initial deposit. They are
+sent either to the fee collector, to be distributed to the validators and
+delegators, or to the community pool.
+
+Example:
+$ %[1]s tx gov submit-legacy-proposal release-escrowed-fees fee-collector --title="..." --description="..." --deposit=10000000uatom --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			destination, ok := escrowDestinations[args[0]]
+			if !ok {
+				return fmt.Errorf("invalid destination %s, expected fee-collector or community-pool", args[0])
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewReleaseEscrowedFeesProposal(title, description, destination)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
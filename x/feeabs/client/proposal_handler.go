@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/cosmos/gaia/v8/x/feeabs/client/cli"
+)
+
+// ReleaseEscrowedFeesProposalHandler is the release escrowed fees proposal
+// command handler.
+var ReleaseEscrowedFeesProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitReleaseEscrowedFeesProposal)
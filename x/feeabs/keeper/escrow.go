@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/gaia/v8/x/feeabs/types"
+)
+
+// EscrowFees moves the fees paid in fee denoms from the fee collector to the
+// module account, where they are held as swaps are disabled in the liquidity
+// module, until a release escrowed fees proposal sends them to the fee
+// collector or to the community pool. Each escrowed fee is emitted along with
+// its value in the native denom, at the price it is checked at, and the fee
+// denoms without an available price are worth nothing. The fees paid in other
+// denoms are left to the fee collector.
+func (k Keeper) EscrowFees(ctx sdk.Context, fees sdk.Coins) error {
+	nativeDenom := k.stakingKeeper.BondDenom(ctx)
+	// the fees of the genesis transactions are delivered before the
+	// parameters are initialized, and are paid in the native denom
+	if len(fees) == 0 || (len(fees) == 1 && fees[0].Denom == nativeDenom) {
+		return nil
+	}
+	params := k.GetParams(ctx)
+
+	escrowed := sdk.NewCoins()
+	for _, fee := range fees {
+		feeDenom, found := params.FeeDenom(fee.Denom)
+		if !found {
+			continue
+		}
+
+		value := sdk.ZeroInt()
+		if price, err := k.feeDenomPrice(ctx, params, feeDenom); err == nil {
+			value = price.Price.MulInt(fee.Amount).TruncateInt()
+		}
+		escrowed = escrowed.Add(fee)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeEscrowFee,
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyNativeValue, sdk.NewCoin(nativeDenom, value).String()),
+		))
+	}
+
+	if escrowed.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, escrowed)
+}
+
+// ReleaseEscrowedFees executes a release escrowed fees proposal, sending all
+// the escrowed fees to the fee collector or to the community pool.
+func (k Keeper) ReleaseEscrowedFees(ctx sdk.Context, p *types.ReleaseEscrowedFeesProposal) error {
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+	fees := k.bankKeeper.GetAllBalances(ctx, escrow)
+	if fees.IsZero() {
+		return types.ErrNoEscrowedFees
+	}
+
+	var err error
+	switch p.Destination {
+	case types.EscrowDestinationFeeCollector:
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, fees)
+	case types.EscrowDestinationCommunityPool:
+		err = k.distrKeeper.FundCommunityPool(ctx, fees, escrow)
+	default:
+		err = sdkerrors.Wrapf(types.ErrInvalidProposal, "invalid destination %s", p.Destination)
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReleaseEscrowedFees,
+		sdk.NewAttribute(types.AttributeKeyFee, fees.String()),
+		sdk.NewAttribute(types.AttributeKeyDestination, p.Destination.String()),
+	))
+	k.Logger(ctx).Info("released escrowed fees", "fees", fees, "destination", p.Destination)
+
+	return nil
+}
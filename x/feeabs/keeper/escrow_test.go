@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v8/app/helpers"
+	"github.com/cosmos/gaia/v8/x/feeabs/types"
+)
+
+func TestReleaseEscrowedFees(t *testing.T) {
+	app := gaiahelpers.Setup(t, false, 0)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	k := app.FeeAbsKeeper
+	k.SetParams(ctx, types.NewParams([]types.FeeDenom{{Denom: ibcDenom, PoolId: 1}}, time.Hour, sdk.NewInt(1_000_000)))
+
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 100))
+	collect := func() {
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+		require.NoError(t, k.EscrowFees(ctx, fees))
+		require.Equal(t, fees, app.BankKeeper.GetAllBalances(ctx, escrow))
+	}
+	release := func(destination types.EscrowDestination) *types.ReleaseEscrowedFeesProposal {
+		return types.NewReleaseEscrowedFeesProposal("title", "description", destination).(*types.ReleaseEscrowedFeesProposal)
+	}
+
+	err := k.ReleaseEscrowedFees(ctx, release(types.EscrowDestinationFeeCollector))
+	require.ErrorIs(t, err, types.ErrNoEscrowedFees)
+
+	collect()
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+	err = k.ReleaseEscrowedFees(ctx, release(types.EscrowDestinationFeeCollector))
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, escrow).IsZero())
+	require.Equal(t, fees, app.BankKeeper.GetAllBalances(ctx, feeCollector))
+
+	// the fee collector is left as is when the fees go to the community pool
+	collect()
+	err = k.ReleaseEscrowedFees(ctx, release(types.EscrowDestinationCommunityPool))
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, escrow).IsZero())
+	require.Equal(t, fees, app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(fees...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/feeabs/types"
+)
+
+// InitGenesis initializes the feeabs module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, observation := range genState.PriceObservations {
+		k.SetPriceObservation(ctx, observation)
+	}
+}
+
+// ExportGenesis returns the feeabs module's genesis state. Only the price
+// observations of the accepted fee denoms are exported.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params := k.GetParams(ctx)
+
+	var observations []types.PriceObservation
+	for _, feeDenom := range params.FeeDenoms {
+		observations = append(observations, k.GetPriceObservations(ctx, feeDenom.Denom)...)
+	}
+
+	return types.NewGenesisState(params, observations)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/feeabs/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// FeeDenomPrice implements the Query/FeeDenomPrice gRPC method. The pricing
+// of an accepted fee denom is returned even if its price is not available.
+func (k Keeper) FeeDenomPrice(c context.Context, req *types.QueryFeeDenomPriceRequest) (*types.QueryFeeDenomPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := k.GetParams(ctx)
+	feeDenom, found := params.FeeDenom(req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "%s is not a fee denom", req.Denom)
+	}
+	price, _ := k.feeDenomPrice(ctx, params, feeDenom)
+
+	return &types.QueryFeeDenomPriceResponse{Price: price}, nil
+}
+
+// FeeDenomPrices implements the Query/FeeDenomPrices gRPC method.
+func (k Keeper) FeeDenomPrices(c context.Context, req *types.QueryFeeDenomPricesRequest) (*types.QueryFeeDenomPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := k.GetParams(ctx)
+	prices := make([]types.FeeDenomPrice, 0, len(params.FeeDenoms))
+	for _, feeDenom := range params.FeeDenoms {
+		price, _ := k.feeDenomPrice(ctx, params, feeDenom)
+		prices = append(prices, price)
+	}
+
+	return &types.QueryFeeDenomPricesResponse{Prices: prices}, nil
+}
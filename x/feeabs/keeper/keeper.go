@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/gaia/v8/x/feeabs/types"
+)
+
+// Keeper of the feeabs store.
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
+
+	bankKeeper      types.BankKeeper
+	distrKeeper     types.DistributionKeeper
+	liquidityKeeper types.LiquidityKeeper
+	stakingKeeper   types.StakingKeeper
+}
+
+// NewKeeper creates a new feeabs Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	paramSpace paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
+	liquidityKeeper types.LiquidityKeeper,
+	stakingKeeper types.StakingKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:             cdc,
+		storeKey:        key,
+		paramSpace:      paramSpace,
+		bankKeeper:      bankKeeper,
+		distrKeeper:     distrKeeper,
+		liquidityKeeper: liquidityKeeper,
+		stakingKeeper:   stakingKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the parameters of the module.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the parameters of the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/feeabs/types"
+)
+
+// RecordPrices records the spot prices of the fee denoms, and prunes their
+// price observations which fell out of the TWAP window. The observations of
+// the denoms which are no longer accepted are left until they are accepted
+// again, and are never read meanwhile.
+func (k Keeper) RecordPrices(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	for _, feeDenom := range params.FeeDenoms {
+		if price, _, err := k.spotPrice(ctx, params, feeDenom); err == nil {
+			k.SetPriceObservation(ctx, types.PriceObservation{
+				Denom: feeDenom.Denom,
+				Time:  ctx.BlockTime(),
+				Price: price,
+			})
+		}
+		k.prunePriceObservations(ctx, feeDenom.Denom, ctx.BlockTime().Add(-params.TwapWindow))
+	}
+}
+
+// ConvertFees converts the fees paid in fee denoms to the native denom, at
+// the lowest of their spot price and TWAP. The fees paid in other denoms are
+// returned as is, and the fee denoms without an available price are worth
+// nothing.
+func (k Keeper) ConvertFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins {
+	params := k.GetParams(ctx)
+	nativeDenom := k.stakingKeeper.BondDenom(ctx)
+
+	converted := sdk.NewCoins()
+	for _, fee := range fees {
+		feeDenom, found := params.FeeDenom(fee.Denom)
+		if !found {
+			converted = converted.Add(fee)
+			continue
+		}
+
+		price, err := k.feeDenomPrice(ctx, params, feeDenom)
+		if err != nil {
+			continue
+		}
+		converted = converted.Add(sdk.NewCoin(nativeDenom, price.Price.MulInt(fee.Amount).TruncateInt()))
+	}
+
+	return converted
+}
+
+// feeDenomPrice returns the pricing of a fee denom, and an error if its price
+// is not available. The returned pricing holds the available prices even
+// then.
+func (k Keeper) feeDenomPrice(ctx sdk.Context, params types.Params, feeDenom types.FeeDenom) (types.FeeDenomPrice, error) {
+	price := types.FeeDenomPrice{
+		Denom:         feeDenom.Denom,
+		PoolId:        feeDenom.PoolId,
+		NativeReserve: sdk.ZeroInt(),
+		SpotPrice:     sdk.ZeroDec(),
+		Twap:          sdk.ZeroDec(),
+		Price:         sdk.ZeroDec(),
+	}
+
+	spot, nativeReserve, spotErr := k.spotPrice(ctx, params, feeDenom)
+	if !nativeReserve.IsNil() {
+		price.NativeReserve = nativeReserve
+	}
+	if spotErr == nil {
+		price.SpotPrice = spot
+	}
+	twap, twapErr := k.twap(ctx, params, feeDenom.Denom)
+	if twapErr == nil {
+		price.Twap = twap
+	}
+
+	switch {
+	case spotErr != nil:
+		return price, spotErr
+	case twapErr != nil:
+		return price, twapErr
+	}
+
+	// the lowest price protects against the pools being manipulated to
+	// inflate the value of the fee denom
+	price.Price = sdk.MinDec(spot, twap)
+	return price, nil
+}
+
+// spotPrice returns the current price of a fee denom in its liquidity pool,
+// and the native denom reserve of the pool. It returns an error if the pool
+// does not pair the fee denom with the native denom, or if its native denom
+// reserve is below the minimum pool liquidity.
+func (k Keeper) spotPrice(ctx sdk.Context, params types.Params, feeDenom types.FeeDenom) (sdk.Dec, sdk.Int, error) {
+	pool, found := k.liquidityKeeper.GetPool(ctx, feeDenom.PoolId)
+	if !found {
+		return sdk.Dec{}, sdk.Int{}, types.ErrPoolNotFound.Wrapf("id %d", feeDenom.PoolId)
+	}
+
+	nativeDenom := k.stakingKeeper.BondDenom(ctx)
+	denoms := pool.ReserveCoinDenoms
+	if len(denoms) != 2 ||
+		!((denoms[0] == nativeDenom && denoms[1] == feeDenom.Denom) || (denoms[0] == feeDenom.Denom && denoms[1] == nativeDenom)) {
+		return sdk.Dec{}, sdk.Int{}, types.ErrInvalidPool.Wrapf("pool %d reserves %v", pool.Id, denoms)
+	}
+	if k.liquidityKeeper.IsDepletedPool(ctx, pool) {
+		return sdk.Dec{}, sdk.ZeroInt(), types.ErrInsufficientPoolLiquidity.Wrapf("pool %d is depleted", pool.Id)
+	}
+
+	reserves := k.liquidityKeeper.GetReserveCoins(ctx, pool)
+	nativeReserve, feeReserve := reserves.AmountOf(nativeDenom), reserves.AmountOf(feeDenom.Denom)
+	if nativeReserve.LT(params.MinPoolLiquidity) || !feeReserve.IsPositive() {
+		return sdk.Dec{}, nativeReserve, types.ErrInsufficientPoolLiquidity.Wrapf(
+			"pool %d reserves %s, minimum is %s%s", pool.Id, reserves, params.MinPoolLiquidity, nativeDenom,
+		)
+	}
+
+	return nativeReserve.ToDec().QuoInt(feeReserve), nativeReserve, nil
+}
+
+// twap returns the time weighted average price of a fee denom over the TWAP
+// window ending at the block time. Each price observation holds until the
+// next one.
+func (k Keeper) twap(ctx sdk.Context, params types.Params, denom string) (sdk.Dec, error) {
+	end := ctx.BlockTime()
+	start := end.Add(-params.TwapWindow)
+
+	observations := k.GetPriceObservations(ctx, denom)
+	weightedSum, totalWeight := sdk.ZeroDec(), sdk.ZeroDec()
+	var latest *types.PriceObservation
+	for i, observation := range observations {
+		if observation.Time.After(end) {
+			break
+		}
+		latest = &observations[i]
+
+		from, to := observation.Time, end
+		if from.Before(start) {
+			from = start
+		}
+		if i+1 < len(observations) && observations[i+1].Time.Before(end) {
+			to = observations[i+1].Time
+		}
+		if !to.After(from) {
+			continue
+		}
+
+		weight := sdk.NewDec(int64(to.Sub(from)))
+		weightedSum = weightedSum.Add(observation.Price.Mul(weight))
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	switch {
+	case totalWeight.IsPositive():
+		return weightedSum.Quo(totalWeight), nil
+	case latest != nil && !latest.Time.Before(start):
+		// the only observation in the window is at the block time
+		return latest.Price, nil
+	default:
+		return sdk.Dec{}, types.ErrNoPriceObservation.Wrap(denom)
+	}
+}
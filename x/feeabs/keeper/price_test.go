@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	liquiditytypes "github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v8/app/helpers"
+	"github.com/cosmos/gaia/v8/x/feeabs/types"
+)
+
+const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+func TestConvertFees(t *testing.T) {
+	app := gaiahelpers.Setup(t, false, 0)
+	start := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: start})
+	k := app.FeeAbsKeeper
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// a pool pricing the ibc denom at 2 native tokens
+	creator := sdk.AccAddress("creator_____________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000_000), sdk.NewInt64Coin(ibcDenom, 100_000_000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, creator, coins))
+	pool, err := app.LiquidityKeeper.CreatePool(ctx, liquiditytypes.NewMsgCreatePool(creator, liquiditytypes.DefaultPoolTypeID,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10_000_000), sdk.NewInt64Coin(ibcDenom, 5_000_000))))
+	require.NoError(t, err)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 100), sdk.NewInt64Coin("other", 10))
+	require.Equal(t, fees, k.ConvertFees(ctx, fees), "fee denoms are not accepted by default")
+
+	k.SetParams(ctx, types.NewParams([]types.FeeDenom{{Denom: ibcDenom, PoolId: pool.Id}}, time.Hour, sdk.NewInt(1_000_000)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("other", 10)), k.ConvertFees(ctx, fees), "no price is observed yet")
+
+	k.RecordPrices(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 200), sdk.NewInt64Coin("other", 10)), k.ConvertFees(ctx, fees))
+
+	// a pool manipulated to inflate the price of the ibc denom only weighs
+	// in the TWAP for the time it lasts
+	reserveAcc := pool.GetReserveAccount()
+	inflation := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 30_000_000))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, creator, reserveAcc, inflation))
+	ctx = ctx.WithBlockTime(start.Add(45 * time.Minute))
+	k.RecordPrices(ctx)
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+
+	res, err := k.FeeDenomPrice(sdk.WrapSDKContext(ctx), &types.QueryFeeDenomPriceRequest{Denom: ibcDenom})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(8), res.Price.SpotPrice)
+	require.Equal(t, sdk.NewInt(40_000_000), res.Price.NativeReserve)
+	require.Equal(t, sdk.MustNewDecFromStr("3.5"), res.Price.Twap)
+	require.Equal(t, res.Price.Twap, res.Price.Price)
+
+	// while a pool manipulated to deflate it is priced at the spot price
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, creator, reserveAcc, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 35_000_000))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), k.ConvertFees(ctx, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 100))))
+
+	// pools below the minimum liquidity do not price the fee denoms
+	k.SetParams(ctx, types.NewParams([]types.FeeDenom{{Denom: ibcDenom, PoolId: pool.Id}}, time.Hour, sdk.NewInt(50_000_000)))
+	require.True(t, k.ConvertFees(ctx, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 100))).IsZero())
+	res, err = k.FeeDenomPrice(sdk.WrapSDKContext(ctx), &types.QueryFeeDenomPriceRequest{Denom: ibcDenom})
+	require.NoError(t, err)
+	require.True(t, res.Price.Price.IsZero())
+	require.True(t, res.Price.SpotPrice.IsZero())
+
+	// the observations out of the window are pruned, except for the price at
+	// the start of the window
+	k.SetParams(ctx, types.NewParams([]types.FeeDenom{{Denom: ibcDenom, PoolId: pool.Id}}, time.Hour, sdk.NewInt(1_000_000)))
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Hour))
+	k.RecordPrices(ctx)
+	observations := k.GetPriceObservations(ctx, ibcDenom)
+	require.Len(t, observations, 2)
+	require.Equal(t, start.Add(45*time.Minute), observations[0].Time)
+
+	_, err = k.FeeDenomPrice(sdk.WrapSDKContext(ctx), &types.QueryFeeDenomPriceRequest{Denom: "other"})
+	require.Error(t, err)
+
+	genState := k.ExportGenesis(ctx)
+	require.NoError(t, genState.Validate())
+	require.Equal(t, observations, genState.PriceObservations)
+}
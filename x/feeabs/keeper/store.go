@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/feeabs/types"
+)
+
+// SetPriceObservation sets a price observation of a fee denom.
+func (k Keeper) SetPriceObservation(ctx sdk.Context, observation types.PriceObservation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PriceObservationKey(observation.Denom, observation.Time), k.cdc.MustMarshal(&observation))
+}
+
+// GetPriceObservations returns the price observations of a fee denom, by
+// ascending time.
+func (k Keeper) GetPriceObservations(ctx sdk.Context, denom string) []types.PriceObservation {
+	var observations []types.PriceObservation
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PriceObservationsPrefix(denom))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &observation)
+		observations = append(observations, observation)
+	}
+
+	return observations
+}
+
+// prunePriceObservations deletes the price observations of a fee denom older
+// than a time, except for the latest of them which is the price at that time.
+func (k Keeper) prunePriceObservations(ctx sdk.Context, denom string, before time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.PriceObservationsPrefix(denom), types.PriceObservationKey(denom, before))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for i := 0; i < len(keys)-1; i++ {
+		store.Delete(keys[i])
+	}
+}
@@ -0,0 +1,24 @@
+package feeabs
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos/gaia/v8/x/feeabs/keeper"
+	"github.com/cosmos/gaia/v8/x/feeabs/types"
+)
+
+// NewReleaseEscrowedFeesProposalHandler creates a governance handler
+// executing the release escrowed fees proposals.
+func NewReleaseEscrowedFeesProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ReleaseEscrowedFeesProposal:
+			return k.ReleaseEscrowedFees(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized feeabs proposal content type: %T", c)
+		}
+	}
+}
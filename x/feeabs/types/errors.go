@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feeabs errors
+var (
+	ErrFeeDenomNotAllowed        = sdkerrors.Register(ModuleName, 2, "denom not allowed to pay fees")
+	ErrPoolNotFound              = sdkerrors.Register(ModuleName, 3, "liquidity pool not found")
+	ErrInvalidPool               = sdkerrors.Register(ModuleName, 4, "liquidity pool does not pair the fee denom with the native denom")
+	ErrInsufficientPoolLiquidity = sdkerrors.Register(ModuleName, 5, "insufficient liquidity pool reserves")
+	ErrNoPriceObservation        = sdkerrors.Register(ModuleName, 6, "no price observation within the TWAP window")
+	ErrInvalidProposal           = sdkerrors.Register(ModuleName, 7, "invalid proposal")
+	ErrNoEscrowedFees            = sdkerrors.Register(ModuleName, 8, "no escrowed fees")
+)
@@ -0,0 +1,11 @@
+package types
+
+// feeabs module event types and attributes
+const (
+	EventTypeEscrowFee           = "escrow_fee"
+	EventTypeReleaseEscrowedFees = "release_escrowed_fees"
+
+	AttributeKeyFee         = "fee"
+	AttributeKeyNativeValue = "native_value"
+	AttributeKeyDestination = "destination"
+)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	liquiditytypes "github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+// DistributionKeeper defines the expected distribution keeper.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// LiquidityKeeper defines the expected liquidity keeper.
+type LiquidityKeeper interface {
+	GetPool(ctx sdk.Context, poolID uint64) (liquiditytypes.Pool, bool)
+	GetReserveCoins(ctx sdk.Context, pool liquiditytypes.Pool) sdk.Coins
+	IsDepletedPool(ctx sdk.Context, pool liquiditytypes.Pool) bool
+}
+
+// BankKeeper defines the expected bank keeper.
+type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the expected staking keeper.
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+}
@@ -0,0 +1,1629 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/feeabs/v1beta1/feeabs.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EscrowDestination is where the escrowed fees are released to.
+type EscrowDestination int32
+
+const (
+	// ESCROW_DESTINATION_UNSPECIFIED defines no destination.
+	EscrowDestinationUnspecified EscrowDestination = 0
+	// ESCROW_DESTINATION_FEE_COLLECTOR defines the fee collector, from which
+	// the fees are distributed to the validators and delegators.
+	EscrowDestinationFeeCollector EscrowDestination = 1
+	// ESCROW_DESTINATION_COMMUNITY_POOL defines the community pool.
+	EscrowDestinationCommunityPool EscrowDestination = 2
+)
+
+var EscrowDestination_name = map[int32]string{
+	0: "ESCROW_DESTINATION_UNSPECIFIED",
+	1: "ESCROW_DESTINATION_FEE_COLLECTOR",
+	2: "ESCROW_DESTINATION_COMMUNITY_POOL",
+}
+
+var EscrowDestination_value = map[string]int32{
+	"ESCROW_DESTINATION_UNSPECIFIED":    0,
+	"ESCROW_DESTINATION_FEE_COLLECTOR":  1,
+	"ESCROW_DESTINATION_COMMUNITY_POOL": 2,
+}
+
+func (x EscrowDestination) String() string {
+	return proto.EnumName(EscrowDestination_name, int32(x))
+}
+
+func (EscrowDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f87c946c11809512, []int{0}
+}
+
+// Params defines the parameters of the feeabs module.
+type Params struct {
+	// fee_denoms are the non-native denoms accepted to pay fees, along with
+	// the liquidity pools pricing them in the native denom.
+	FeeDenoms []FeeDenom `protobuf:"bytes,1,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms"`
+	// twap_window is the duration over which the time weighted average prices
+	// of the fee denoms are computed.
+	TwapWindow time.Duration `protobuf:"bytes,2,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window"`
+	// min_pool_liquidity is the minimum native denom reserve of the liquidity
+	// pool of a fee denom for it to be priced.
+	MinPoolLiquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_pool_liquidity,json=minPoolLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_pool_liquidity"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f87c946c11809512, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+// FeeDenom is a non-native denom accepted to pay fees.
+type FeeDenom struct {
+	// denom is the accepted denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pool_id is the id of the liquidity pool of the denom and the native
+	// denom pricing it.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f87c946c11809512, []int{1}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// PriceObservation is the spot price of a fee denom observed at the
+// beginning of a block.
+type PriceObservation struct {
+	// denom is the observed fee denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// time is the time of the block of the observation.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// price is the price of the fee denom, in native denom per fee denom.
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *PriceObservation) Reset()         { *m = PriceObservation{} }
+func (m *PriceObservation) String() string { return proto.CompactTextString(m) }
+func (*PriceObservation) ProtoMessage()    {}
+func (*PriceObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f87c946c11809512, []int{2}
+}
+func (m *PriceObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceObservation.Merge(m, src)
+}
+func (m *PriceObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceObservation proto.InternalMessageInfo
+
+func (m *PriceObservation) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PriceObservation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// FeeDenomPrice is the pricing of a fee denom. Prices are expressed in native
+// denom per fee denom, and are zero when they are not available.
+type FeeDenomPrice struct {
+	// denom is the fee denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pool_id is the id of the liquidity pool pricing the denom.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// native_reserve is the native denom reserve of the pool.
+	NativeReserve github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=native_reserve,json=nativeReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"native_reserve"`
+	// spot_price is the current price of the pool.
+	SpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price"`
+	// twap is the time weighted average price over the TWAP window.
+	Twap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
+	// price is the price the fees are converted at, the lowest of the spot
+	// price and the TWAP.
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *FeeDenomPrice) Reset()         { *m = FeeDenomPrice{} }
+func (m *FeeDenomPrice) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomPrice) ProtoMessage()    {}
+func (*FeeDenomPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f87c946c11809512, []int{3}
+}
+func (m *FeeDenomPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomPrice.Merge(m, src)
+}
+func (m *FeeDenomPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomPrice proto.InternalMessageInfo
+
+func (m *FeeDenomPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenomPrice) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// ReleaseEscrowedFeesProposal is a governance proposal releasing the fees
+// escrowed in the fee denoms.
+type ReleaseEscrowedFeesProposal struct {
+	// title is the title of the proposal.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description is the description of the proposal.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// destination is where the escrowed fees are released to.
+	Destination EscrowDestination `protobuf:"varint,3,opt,name=destination,proto3,enum=gaia.feeabs.v1beta1.EscrowDestination" json:"destination,omitempty"`
+}
+
+func (m *ReleaseEscrowedFeesProposal) Reset()      { *m = ReleaseEscrowedFeesProposal{} }
+func (*ReleaseEscrowedFeesProposal) ProtoMessage() {}
+func (*ReleaseEscrowedFeesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f87c946c11809512, []int{4}
+}
+func (m *ReleaseEscrowedFeesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseEscrowedFeesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseEscrowedFeesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseEscrowedFeesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseEscrowedFeesProposal.Merge(m, src)
+}
+func (m *ReleaseEscrowedFeesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseEscrowedFeesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseEscrowedFeesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseEscrowedFeesProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("gaia.feeabs.v1beta1.EscrowDestination", EscrowDestination_name, EscrowDestination_value)
+	proto.RegisterType((*Params)(nil), "gaia.feeabs.v1beta1.Params")
+	proto.RegisterType((*FeeDenom)(nil), "gaia.feeabs.v1beta1.FeeDenom")
+	proto.RegisterType((*PriceObservation)(nil), "gaia.feeabs.v1beta1.PriceObservation")
+	proto.RegisterType((*FeeDenomPrice)(nil), "gaia.feeabs.v1beta1.FeeDenomPrice")
+	proto.RegisterType((*ReleaseEscrowedFeesProposal)(nil), "gaia.feeabs.v1beta1.ReleaseEscrowedFeesProposal")
+}
+
+func init() { proto.RegisterFile("gaia/feeabs/v1beta1/feeabs.proto", fileDescriptor_f87c946c11809512) }
+
+var fileDescriptor_f87c946c11809512 = []byte{
+	// 726 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x4f, 0xe3, 0x46,
+	0x18, 0xb5, 0x21, 0xa4, 0x64, 0x22, 0x50, 0xea, 0x22, 0x35, 0x4d, 0x8b, 0x63, 0x72, 0x40, 0x51,
+	0xa5, 0xda, 0x82, 0x5e, 0x28, 0xb7, 0x26, 0x76, 0x5a, 0x4b, 0x21, 0x8e, 0x4c, 0x22, 0xd4, 0xaa,
+	0x92, 0xe5, 0xd8, 0x93, 0x74, 0x54, 0xdb, 0xe3, 0x7a, 0x26, 0xc9, 0xf2, 0x0f, 0x10, 0x27, 0x8e,
+	0x5c, 0x90, 0x90, 0xf6, 0xb2, 0xfb, 0x4f, 0x38, 0x72, 0x5c, 0xed, 0x81, 0x5d, 0xc1, 0x7d, 0x2f,
+	0x2b, 0xed, 0x79, 0x35, 0x63, 0x47, 0xa0, 0x4d, 0xf6, 0xb0, 0xd9, 0x53, 0xf2, 0x79, 0xde, 0x7b,
+	0xf3, 0xbd, 0xf7, 0xcd, 0x0c, 0x50, 0x46, 0x2e, 0x72, 0xb5, 0x21, 0x84, 0xee, 0x80, 0x68, 0x93,
+	0xbd, 0x01, 0xa4, 0xee, 0x5e, 0x56, 0xaa, 0x71, 0x82, 0x29, 0x96, 0xbe, 0x63, 0x08, 0x35, 0xfb,
+	0x94, 0x21, 0x2a, 0x5b, 0x23, 0x3c, 0xc2, 0x7c, 0x5d, 0x63, 0xff, 0x52, 0x68, 0x45, 0x1e, 0x61,
+	0x3c, 0x0a, 0xa0, 0xc6, 0xab, 0xc1, 0x78, 0xa8, 0xf9, 0xe3, 0xc4, 0xa5, 0x08, 0x47, 0xd9, 0x7a,
+	0xf5, 0xd3, 0x75, 0x8a, 0x42, 0x48, 0xa8, 0x1b, 0xc6, 0x29, 0xa0, 0xf6, 0x41, 0x04, 0xf9, 0xae,
+	0x9b, 0xb8, 0x21, 0x91, 0x1a, 0x00, 0x0c, 0x21, 0x74, 0x7c, 0x18, 0xe1, 0x90, 0x94, 0x45, 0x65,
+	0xb5, 0x5e, 0xdc, 0xdf, 0x56, 0x17, 0xf4, 0xa2, 0xb6, 0x20, 0xd4, 0x19, 0xaa, 0x91, 0xbb, 0xb9,
+	0xab, 0x0a, 0x76, 0x61, 0x98, 0xd5, 0x44, 0xd2, 0x41, 0x91, 0x4e, 0xdd, 0xd8, 0x99, 0xa2, 0xc8,
+	0xc7, 0xd3, 0xf2, 0x8a, 0x22, 0xd6, 0x8b, 0xfb, 0x3f, 0xa8, 0x69, 0x17, 0xea, 0xac, 0x0b, 0x55,
+	0xcf, 0xba, 0x6c, 0xac, 0x33, 0x81, 0xcb, 0x37, 0x55, 0xd1, 0x06, 0x8c, 0x77, 0xc2, 0x69, 0xd2,
+	0x3f, 0x40, 0x0a, 0x51, 0xe4, 0xc4, 0x18, 0x07, 0x4e, 0x80, 0xfe, 0x1f, 0x23, 0x1f, 0xd1, 0xd3,
+	0xf2, 0xaa, 0x22, 0xd6, 0x0b, 0x0d, 0x95, 0x31, 0x5e, 0xdf, 0x55, 0x77, 0x47, 0x88, 0xfe, 0x3b,
+	0x1e, 0xa8, 0x1e, 0x0e, 0x35, 0x0f, 0x93, 0x10, 0x93, 0xec, 0xe7, 0x17, 0xe2, 0xff, 0xa7, 0xd1,
+	0xd3, 0x18, 0x12, 0xd5, 0x8c, 0xa8, 0x5d, 0x0a, 0x51, 0xd4, 0xc5, 0x38, 0x68, 0xcf, 0x74, 0x0e,
+	0x73, 0x97, 0xd7, 0x55, 0xa1, 0xf6, 0x1b, 0x58, 0x9f, 0xd9, 0x90, 0xb6, 0xc0, 0x1a, 0x77, 0x5d,
+	0x16, 0xd9, 0x16, 0x76, 0x5a, 0x48, 0xdf, 0x83, 0x6f, 0x78, 0x07, 0xc8, 0xe7, 0x3e, 0x72, 0x76,
+	0x9e, 0x95, 0xa6, 0x5f, 0x7b, 0x21, 0x82, 0x52, 0x37, 0x41, 0x1e, 0xb4, 0x06, 0x04, 0x26, 0x13,
+	0xee, 0xe4, 0x33, 0x1a, 0x07, 0x20, 0xc7, 0x12, 0xcf, 0x82, 0xa8, 0xcc, 0x05, 0xd1, 0x9b, 0x8d,
+	0x23, 0x4d, 0xe2, 0x82, 0x25, 0xc1, 0x19, 0x92, 0x0e, 0xd6, 0x62, 0xb6, 0xc7, 0x12, 0xb6, 0x75,
+	0xe8, 0xd9, 0x29, 0xb9, 0xf6, 0x6e, 0x05, 0x6c, 0xcc, 0x6c, 0xf2, 0x96, 0xbf, 0xd0, 0xab, 0xd4,
+	0x07, 0x9b, 0x91, 0x4b, 0xd1, 0x04, 0x3a, 0x09, 0x64, 0x66, 0xe1, 0x92, 0x63, 0xd8, 0x48, 0x55,
+	0xec, 0x54, 0x44, 0x3a, 0x02, 0x80, 0xc4, 0x98, 0x3a, 0xa9, 0xc5, 0xdc, 0x52, 0x16, 0x0b, 0x4c,
+	0x21, 0x35, 0xd5, 0x00, 0x39, 0x76, 0x7c, 0xca, 0x6b, 0x4b, 0x09, 0x71, 0xee, 0x63, 0xe0, 0xf9,
+	0xaf, 0x09, 0xfc, 0xa5, 0x08, 0x7e, 0xb4, 0x61, 0x00, 0x5d, 0x02, 0x0d, 0xe2, 0x25, 0x78, 0x0a,
+	0xfd, 0x16, 0x84, 0xa4, 0x9b, 0xe0, 0x18, 0x13, 0x37, 0x60, 0xf1, 0x53, 0x44, 0x03, 0x38, 0x8b,
+	0x9f, 0x17, 0x92, 0x02, 0x8a, 0x3e, 0x24, 0x5e, 0x82, 0x62, 0x76, 0x96, 0xf8, 0x08, 0x0a, 0xf6,
+	0xd3, 0x4f, 0xd2, 0x9f, 0x1c, 0x41, 0x51, 0xc4, 0x4f, 0x1b, 0x1f, 0xc2, 0xe6, 0xfe, 0xee, 0xc2,
+	0xdb, 0x99, 0xee, 0xab, 0x3f, 0xa2, 0xed, 0xa7, 0xd4, 0xc3, 0xf5, 0xb3, 0xeb, 0xaa, 0xc0, 0xae,
+	0xc0, 0xcf, 0xef, 0x45, 0xf0, 0xed, 0x1c, 0x58, 0xd2, 0x81, 0x6c, 0x1c, 0x37, 0x6d, 0xeb, 0xc4,
+	0xd1, 0x8d, 0xe3, 0x9e, 0xd9, 0xf9, 0xbd, 0x67, 0x5a, 0x1d, 0xa7, 0xdf, 0x39, 0xee, 0x1a, 0x4d,
+	0xb3, 0x65, 0x1a, 0x7a, 0x49, 0xa8, 0x28, 0xe7, 0x57, 0xca, 0x4f, 0x73, 0xd4, 0x7e, 0x44, 0x62,
+	0xe8, 0xa1, 0x21, 0x82, 0xbe, 0xf4, 0x07, 0x50, 0x16, 0xa8, 0xb4, 0x0c, 0xc3, 0x69, 0x5a, 0xed,
+	0xb6, 0xd1, 0xec, 0x59, 0x76, 0x49, 0xac, 0xec, 0x9c, 0x5f, 0x29, 0xdb, 0x73, 0x3a, 0x2d, 0x08,
+	0x9b, 0x38, 0x08, 0xa0, 0x47, 0x71, 0x22, 0x99, 0x60, 0x67, 0x81, 0x50, 0xd3, 0x3a, 0x3a, 0xea,
+	0x77, 0xcc, 0xde, 0x5f, 0x4e, 0xd7, 0xb2, 0xda, 0xa5, 0x95, 0x4a, 0xed, 0xfc, 0x4a, 0x91, 0xe7,
+	0x94, 0x9a, 0x38, 0x0c, 0xc7, 0x11, 0xa2, 0xa7, 0xec, 0x05, 0xa8, 0xe4, 0xce, 0x9e, 0xcb, 0x42,
+	0xa3, 0x71, 0x73, 0x2f, 0x8b, 0xb7, 0xf7, 0xb2, 0xf8, 0xf6, 0x5e, 0x16, 0x2f, 0x1e, 0x64, 0xe1,
+	0xf6, 0x41, 0x16, 0x5e, 0x3d, 0xc8, 0xc2, 0xdf, 0xf5, 0xf9, 0x51, 0xf3, 0xb7, 0x7a, 0x72, 0xa0,
+	0x3d, 0x9b, 0x3d, 0xd8, 0x7c, 0xe0, 0x83, 0x3c, 0xbf, 0xc0, 0xbf, 0x7e, 0x1c, 0x00, 0x0c, 0x80,
+	0x79, 0x9b, 0xcc, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinPoolLiquidity.Size()
+		i -= size
+		if _, err := m.MinPoolLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFeeabs(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeabs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintFeeabs(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFeeabs(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenomPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NativeReserve.Size()
+		i -= size
+		if _, err := m.NativeReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintFeeabs(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseEscrowedFeesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseEscrowedFeesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseEscrowedFeesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintFeeabs(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeabs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeabs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovFeeabs(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovFeeabs(uint64(l))
+	l = m.MinPoolLiquidity.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovFeeabs(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PriceObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovFeeabs(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	return n
+}
+
+func (m *FeeDenomPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovFeeabs(uint64(m.PoolId))
+	}
+	l = m.NativeReserve.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	l = m.Twap.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	return n
+}
+
+func (m *ReleaseEscrowedFeesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovFeeabs(uint64(m.Destination))
+	}
+	return n
+}
+
+func sovFeeabs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeabs(x uint64) (n int) {
+	return sovFeeabs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPoolLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseEscrowedFeesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseEscrowedFeesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseEscrowedFeesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= EscrowDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeabs(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeabs
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeabs
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeabs
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeabs        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeabs          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeabs = fmt.Errorf("proto: unexpected end of group")
+)
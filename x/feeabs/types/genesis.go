@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState instance.
+func NewGenesisState(params Params, observations []PriceObservation) *GenesisState {
+	return &GenesisState{
+		Params:            params,
+		PriceObservations: observations,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the feeabs module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	observations := make(map[string]bool, len(gs.PriceObservations))
+	for _, observation := range gs.PriceObservations {
+		if _, found := gs.Params.FeeDenom(observation.Denom); !found {
+			return fmt.Errorf("price observation of %s, which is not a fee denom", observation.Denom)
+		}
+		key := string(PriceObservationKey(observation.Denom, observation.Time))
+		if observations[key] {
+			return fmt.Errorf("duplicate price observation of %s at %s", observation.Denom, observation.Time)
+		}
+		observations[key] = true
+
+		if observation.Price.IsNil() || !observation.Price.IsPositive() {
+			return fmt.Errorf("non positive price observation of %s at %s", observation.Denom, observation.Time)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the name of the feeabs module.
+	ModuleName = "feeabs"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey defines the governance router key of the module.
+	RouterKey = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// PriceObservationKeyPrefix prefixes the price observations of the fee
+	// denoms, by denom and time.
+	PriceObservationKeyPrefix = []byte{0x01}
+)
+
+// PriceObservationsPrefix returns the prefix of the price observations of a
+// fee denom.
+func PriceObservationsPrefix(denom string) []byte {
+	return append(PriceObservationKeyPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// PriceObservationKey returns the key of the price observation of a fee denom
+// at a time.
+func PriceObservationKey(denom string, t time.Time) []byte {
+	return append(PriceObservationsPrefix(denom), sdk.FormatTimeBytes(t)...)
+}
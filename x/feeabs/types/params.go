@@ -0,0 +1,134 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"sigs.k8s.io/yaml"
+)
+
+// Default parameter values
+var (
+	DefaultTWAPWindow       = time.Hour
+	DefaultMinPoolLiquidity = sdk.NewInt(1_000_000_000)
+)
+
+// Parameter store keys
+var (
+	KeyFeeDenoms        = []byte("FeeDenoms")
+	KeyTWAPWindow       = []byte("TWAPWindow")
+	KeyMinPoolLiquidity = []byte("MinPoolLiquidity")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table of the feeabs module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(feeDenoms []FeeDenom, twapWindow time.Duration, minPoolLiquidity sdk.Int) Params {
+	return Params{
+		FeeDenoms:        feeDenoms,
+		TwapWindow:       twapWindow,
+		MinPoolLiquidity: minPoolLiquidity,
+	}
+}
+
+// DefaultParams returns the default parameters of the feeabs module, which
+// accept no fee denom.
+func DefaultParams() Params {
+	return NewParams(nil, DefaultTWAPWindow, DefaultMinPoolLiquidity)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeDenoms, &p.FeeDenoms, validateFeeDenoms),
+		paramtypes.NewParamSetPair(KeyTWAPWindow, &p.TwapWindow, validateTWAPWindow),
+		paramtypes.NewParamSetPair(KeyMinPoolLiquidity, &p.MinPoolLiquidity, validateMinPoolLiquidity),
+	}
+}
+
+// Validate validates the parameters.
+func (p Params) Validate() error {
+	if err := validateFeeDenoms(p.FeeDenoms); err != nil {
+		return fmt.Errorf("invalid fee denoms: %w", err)
+	}
+	if err := validateTWAPWindow(p.TwapWindow); err != nil {
+		return fmt.Errorf("invalid TWAP window: %w", err)
+	}
+	if err := validateMinPoolLiquidity(p.MinPoolLiquidity); err != nil {
+		return fmt.Errorf("invalid minimum pool liquidity: %w", err)
+	}
+
+	return nil
+}
+
+// FeeDenom returns the fee denom of a denom, if it is accepted to pay fees.
+func (p Params) FeeDenom(denom string) (FeeDenom, bool) {
+	for _, feeDenom := range p.FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
+	}
+
+	return FeeDenom{}, false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateFeeDenoms(i interface{}) error {
+	v, ok := i.([]FeeDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]bool, len(v))
+	for _, feeDenom := range v {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return err
+		}
+		if denoms[feeDenom.Denom] {
+			return fmt.Errorf("duplicate fee denom %s", feeDenom.Denom)
+		}
+		denoms[feeDenom.Denom] = true
+
+		if feeDenom.PoolId == 0 {
+			return fmt.Errorf("missing liquidity pool of fee denom %s", feeDenom.Denom)
+		}
+	}
+
+	return nil
+}
+
+func validateTWAPWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("TWAP window must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinPoolLiquidity(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("minimum pool liquidity must not be negative: %s", v)
+	}
+
+	return nil
+}
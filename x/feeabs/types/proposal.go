@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	// ProposalTypeReleaseEscrowedFees defines the type for a
+	// ReleaseEscrowedFeesProposal.
+	ProposalTypeReleaseEscrowedFees = "ReleaseEscrowedFees"
+)
+
+var _ govtypes.Content = &ReleaseEscrowedFeesProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeReleaseEscrowedFees)
+}
+
+// NewReleaseEscrowedFeesProposal creates a new release escrowed fees
+// proposal.
+func NewReleaseEscrowedFeesProposal(title, description string, destination EscrowDestination) govtypes.Content {
+	return &ReleaseEscrowedFeesProposal{
+		Title:       title,
+		Description: description,
+		Destination: destination,
+	}
+}
+
+// GetTitle returns the title of a release escrowed fees proposal.
+func (p *ReleaseEscrowedFeesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a release escrowed fees
+// proposal.
+func (p *ReleaseEscrowedFeesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a release escrowed fees proposal.
+func (p *ReleaseEscrowedFeesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a release escrowed fees proposal.
+func (p *ReleaseEscrowedFeesProposal) ProposalType() string {
+	return ProposalTypeReleaseEscrowedFees
+}
+
+// ValidateBasic runs basic stateless validity checks.
+func (p *ReleaseEscrowedFeesProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.Destination != EscrowDestinationFeeCollector && p.Destination != EscrowDestinationCommunityPool {
+		return sdkerrors.Wrapf(ErrInvalidProposal, "invalid destination %s", p.Destination)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ReleaseEscrowedFeesProposal) String() string {
+	return fmt.Sprintf(`Release Escrowed Fees Proposal:
+  Title:       %s
+  Description: %s
+  Destination: %s
+`, p.Title, p.Description, p.Destination)
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/ibchealth/types"
+)
+
+// GetQueryCmd returns the IBC health query command, summarizing the health
+// of the IBC light clients, connections and channels, along with its
+// subcommands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-health",
+		Args:  cobra.NoArgs,
+		Short: "Summarize the health of the IBC light clients, connections and channels",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Summarize the health of the IBC light clients, connections and channels of
+the Hub: the numbers of clients by status and of connections and channels by
+state, the status and time to expiry of every client, the soonest to expire
+first, and the state of every connection and channel. A Tendermint client
+expires once the trusting period has elapsed since its latest consensus state,
+unless it is updated.
+
+Example:
+$ %[1]s query ibc-health
+$ %[1]s query ibc-health client 07-tendermint-0
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Summary(cmd.Context(), &types.QuerySummaryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	cmd.AddCommand(
+		GetCmdQueryClientHealth(),
+		GetCmdQueryClientsHealth(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryClientHealth implements the client health query command.
+func GetCmdQueryClientHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client [client-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the status and time to expiry of an IBC light client",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClientHealth(cmd.Context(), &types.QueryClientHealthRequest{ClientId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClientsHealth implements the clients health query command.
+func GetCmdQueryClientsHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clients",
+		Args:  cobra.NoArgs,
+		Short: "Query the statuses and times to expiry of all the IBC light clients",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClientsHealth(cmd.Context(), &types.QueryClientsHealthRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/ibchealth/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the IBC health gRPC query service, reporting the status
+// and the time to expiry of the IBC light clients, along with the states of
+// the connections and channels built on them.
+type Querier struct {
+	cdc              codec.BinaryCodec
+	clientKeeper     types.ClientKeeper
+	connectionKeeper types.ConnectionKeeper
+	channelKeeper    types.ChannelKeeper
+}
+
+// NewQuerier returns a new IBC health Querier.
+func NewQuerier(cdc codec.BinaryCodec, clientKeeper types.ClientKeeper, connectionKeeper types.ConnectionKeeper, channelKeeper types.ChannelKeeper) Querier {
+	return Querier{
+		cdc:              cdc,
+		clientKeeper:     clientKeeper,
+		connectionKeeper: connectionKeeper,
+		channelKeeper:    channelKeeper,
+	}
+}
+
+// ClientHealth implements the Query/ClientHealth gRPC method.
+func (q Querier) ClientHealth(c context.Context, req *types.QueryClientHealthRequest) (*types.QueryClientHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	clientState, found := q.clientKeeper.GetClientState(ctx, req.ClientId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "client %s not found", req.ClientId)
+	}
+
+	return &types.QueryClientHealthResponse{Client: q.clientHealth(ctx, req.ClientId, clientState)}, nil
+}
+
+// ClientsHealth implements the Query/ClientsHealth gRPC method.
+func (q Querier) ClientsHealth(c context.Context, req *types.QueryClientsHealthRequest) (*types.QueryClientsHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryClientsHealthResponse{Clients: q.clientsHealth(ctx)}, nil
+}
+
+// Summary implements the Query/Summary gRPC method.
+func (q Querier) Summary(c context.Context, req *types.QuerySummaryRequest) (*types.QuerySummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QuerySummaryResponse{Clients: q.clientsHealth(ctx)}
+	clientStatuses := make([]string, len(res.Clients))
+	for i, client := range res.Clients {
+		clientStatuses[i] = client.Status
+	}
+
+	connections := q.connectionKeeper.GetAllConnections(ctx)
+	connectionStates := make([]string, len(connections))
+	for i, connection := range connections {
+		connectionStates[i] = connection.State.String()
+		res.Connections = append(res.Connections, types.ConnectionHealth{
+			ConnectionId: connection.Id,
+			ClientId:     connection.ClientId,
+			State:        connectionStates[i],
+		})
+	}
+
+	channels := q.channelKeeper.GetAllChannels(ctx)
+	channelStates := make([]string, len(channels))
+	for i, channel := range channels {
+		channelStates[i] = channel.State.String()
+		var connectionID string
+		if len(channel.ConnectionHops) > 0 {
+			connectionID = channel.ConnectionHops[0]
+		}
+		res.Channels = append(res.Channels, types.ChannelHealth{
+			PortId:       channel.PortId,
+			ChannelId:    channel.ChannelId,
+			ConnectionId: connectionID,
+			State:        channelStates[i],
+		})
+	}
+
+	res.ClientStatuses = stateCounts(clientStatuses)
+	res.ConnectionStates = stateCounts(connectionStates)
+	res.ChannelStates = stateCounts(channelStates)
+
+	return res, nil
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+
+	"github.com/cosmos/gaia/v8/x/ibchealth/types"
+)
+
+// clientHealth returns the health of a client. The expiry of a Tendermint
+// client is the timestamp of its latest consensus state plus its trusting
+// period, past which it can no longer be updated.
+func (q Querier) clientHealth(ctx sdk.Context, clientID string, clientState exported.ClientState) types.ClientHealth {
+	health := types.ClientHealth{
+		ClientId:     clientID,
+		ClientType:   clientState.ClientType(),
+		Status:       clientState.Status(ctx, q.clientKeeper.ClientStore(ctx, clientID), q.cdc).String(),
+		LatestHeight: clientState.GetLatestHeight().String(),
+	}
+
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return health
+	}
+	health.ChainId = tmClientState.ChainId
+
+	consensusState, found := q.clientKeeper.GetClientConsensusState(ctx, clientID, tmClientState.GetLatestHeight())
+	if !found {
+		return health
+	}
+	tmConsensusState, ok := consensusState.(*ibctmtypes.ConsensusState)
+	if !ok {
+		return health
+	}
+
+	health.Expires = true
+	health.LatestTimestamp = tmConsensusState.Timestamp
+	health.TrustingPeriod = tmClientState.TrustingPeriod
+	health.Expiry = tmConsensusState.Timestamp.Add(tmClientState.TrustingPeriod)
+	health.TimeToExpiry = health.Expiry.Sub(ctx.BlockTime())
+
+	return health
+}
+
+// clientsHealth returns the health of all the clients, the soonest to expire
+// first, followed by the clients which do not expire.
+func (q Querier) clientsHealth(ctx sdk.Context) []types.ClientHealth {
+	var clients []types.ClientHealth
+	q.clientKeeper.IterateClients(ctx, func(clientID string, clientState exported.ClientState) bool {
+		clients = append(clients, q.clientHealth(ctx, clientID, clientState))
+		return false
+	})
+
+	sort.SliceStable(clients, func(i, j int) bool {
+		if clients[i].Expires != clients[j].Expires {
+			return clients[i].Expires
+		}
+		return clients[i].Expires && clients[i].TimeToExpiry < clients[j].TimeToExpiry
+	})
+
+	return clients
+}
+
+// stateCounts returns the numbers of items by state, in the order of the
+// states.
+func stateCounts(states []string) []types.StateCount {
+	counts := make(map[string]uint64)
+	for _, state := range states {
+		counts[state]++
+	}
+
+	stateCounts := make([]types.StateCount, 0, len(counts))
+	for state, count := range counts {
+		stateCounts = append(stateCounts, types.StateCount{State: state, Count: count})
+	}
+	sort.Slice(stateCounts, func(i, j int) bool {
+		return stateCounts[i].State < stateCounts[j].State
+	})
+
+	return stateCounts
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiaapp "github.com/cosmos/gaia/v8/app"
+	gaiahelpers "github.com/cosmos/gaia/v8/app/helpers"
+	"github.com/cosmos/gaia/v8/x/ibchealth/keeper"
+	"github.com/cosmos/gaia/v8/x/ibchealth/types"
+)
+
+// recordingLogger is a logger recording the messages and key values logged.
+type recordingLogger struct {
+	logs *bytes.Buffer
+}
+
+func (l recordingLogger) log(msg string, keyVals ...interface{}) {
+	fmt.Fprintln(l.logs, append([]interface{}{msg}, keyVals...)...)
+}
+
+func (l recordingLogger) Debug(msg string, keyVals ...interface{}) { l.log(msg, keyVals...) }
+func (l recordingLogger) Info(msg string, keyVals ...interface{})  { l.log(msg, keyVals...) }
+func (l recordingLogger) Error(msg string, keyVals ...interface{}) { l.log(msg, keyVals...) }
+func (l recordingLogger) With(...interface{}) log.Logger           { return l }
+
+// setClient sets a Tendermint client whose latest consensus state has the
+// given timestamp.
+func setClient(app *gaiaapp.GaiaApp, ctx sdk.Context, clientID, chainID string, trustingPeriod time.Duration, timestamp time.Time) {
+	clientState := ibctmtypes.NewClientState(chainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, 2*trustingPeriod, time.Minute,
+		clienttypes.NewHeight(1, 100), commitmenttypes.GetSDKSpecs(), nil, false, false)
+	app.IBCKeeper.ClientKeeper.SetClientState(ctx, clientID, clientState)
+	app.IBCKeeper.ClientKeeper.SetClientConsensusState(ctx, clientID, clientState.GetLatestHeight(),
+		ibctmtypes.NewConsensusState(timestamp, commitmenttypes.NewMerkleRoot([]byte("root")), []byte("hash")))
+}
+
+func TestClientsHealth(t *testing.T) {
+	app := gaiahelpers.Setup(t, false, 0)
+	start := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: start})
+	q := keeper.NewQuerier(app.AppCodec(), app.IBCKeeper.ClientKeeper, app.IBCKeeper.ConnectionKeeper, app.IBCKeeper.ChannelKeeper)
+
+	setClient(app, ctx, "07-tendermint-0", "osmosis-1", 14*24*time.Hour, start)
+	setClient(app, ctx, "07-tendermint-1", "juno-1", 2*time.Hour, start.Add(-time.Hour))
+	setClient(app, ctx, "07-tendermint-2", "stale-1", time.Hour, start.Add(-2*time.Hour))
+	app.IBCKeeper.ConnectionKeeper.SetConnection(ctx, "connection-0", connectiontypes.NewConnectionEnd(connectiontypes.OPEN, "07-tendermint-0",
+		connectiontypes.NewCounterparty("07-tendermint-7", "connection-7", commitmenttypes.NewMerklePrefix([]byte("ibc"))), nil, 0))
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, "transfer", "channel-0", channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED,
+		channeltypes.NewCounterparty("transfer", "channel-7"), []string{"connection-0"}, "ics20-1"))
+
+	res, err := q.ClientHealth(sdk.WrapSDKContext(ctx), &types.QueryClientHealthRequest{ClientId: "07-tendermint-1"})
+	require.NoError(t, err)
+	require.Equal(t, "juno-1", res.Client.ChainId)
+	require.Equal(t, exported.Active.String(), res.Client.Status)
+	require.Equal(t, "1-100", res.Client.LatestHeight)
+	require.True(t, res.Client.Expires)
+	require.Equal(t, start.Add(time.Hour), res.Client.Expiry)
+	require.Equal(t, time.Hour, res.Client.TimeToExpiry)
+
+	_, err = q.ClientHealth(sdk.WrapSDKContext(ctx), &types.QueryClientHealthRequest{ClientId: "07-tendermint-9"})
+	require.Error(t, err)
+
+	// the clients are sorted by time to expiry
+	summary, err := q.Summary(sdk.WrapSDKContext(ctx), &types.QuerySummaryRequest{})
+	require.NoError(t, err)
+	require.Len(t, summary.Clients, 3)
+	require.Equal(t, "07-tendermint-2", summary.Clients[0].ClientId)
+	require.Equal(t, exported.Expired.String(), summary.Clients[0].Status)
+	require.Equal(t, -time.Hour, summary.Clients[0].TimeToExpiry)
+	require.Equal(t, "07-tendermint-0", summary.Clients[2].ClientId)
+	require.Equal(t, []types.StateCount{{State: "Active", Count: 2}, {State: "Expired", Count: 1}}, summary.ClientStatuses)
+	require.Equal(t, []types.StateCount{{State: connectiontypes.OPEN.String(), Count: 1}}, summary.ConnectionStates)
+	require.Equal(t, []types.ChannelHealth{{PortId: "transfer", ChannelId: "channel-0", ConnectionId: "connection-0", State: channeltypes.OPEN.String()}}, summary.Channels)
+}
+
+func TestWatchdog(t *testing.T) {
+	app := gaiahelpers.Setup(t, false, 0)
+	start := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	var logs bytes.Buffer
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: start}).WithLogger(recordingLogger{&logs})
+	q := keeper.NewQuerier(app.AppCodec(), app.IBCKeeper.ClientKeeper, app.IBCKeeper.ConnectionKeeper, app.IBCKeeper.ChannelKeeper)
+	w := keeper.NewWatchdog(q, 10, []time.Duration{time.Hour, 24 * time.Hour})
+
+	setClient(app, ctx, "07-tendermint-0", "osmosis-1", 48*time.Hour, start)
+	check := func(elapsed time.Duration, height int64) string {
+		logs.Reset()
+		w.Check(ctx.WithBlockTime(start.Add(elapsed)).WithBlockHeight(height))
+		return logs.String()
+	}
+
+	require.Empty(t, check(0, 10))
+	require.Empty(t, check(30*time.Hour, 15), "the clients are checked at the interval only")
+	require.Contains(t, check(30*time.Hour, 20), "IBC client expires soon")
+	require.Empty(t, check(31*time.Hour, 30), "a threshold is only reported once")
+
+	out := check(47*time.Hour+30*time.Minute, 40)
+	require.Contains(t, out, "IBC client expires soon")
+	require.Contains(t, out, "threshold 1h0m0s")
+
+	require.Contains(t, check(49*time.Hour, 50), "IBC client is no longer active")
+	require.Empty(t, check(50*time.Hour, 60))
+
+	// the thresholds are reported again once the client is updated
+	setClient(app, ctx, "07-tendermint-0", "osmosis-1", 48*time.Hour, start.Add(50*time.Hour))
+	require.Contains(t, check(50*time.Hour, 70), "IBC client is active again")
+	require.Contains(t, check(80*time.Hour, 80), "IBC client expires soon")
+}
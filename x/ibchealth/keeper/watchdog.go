@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	gaiatelemetry "github.com/cosmos/gaia/v8/telemetry"
+	"github.com/cosmos/gaia/v8/x/ibchealth/types"
+)
+
+// Watchdog periodically checks the health of the IBC light clients, records
+// their time to expiry and status as metrics, and logs a warning whenever the
+// time to expiry of a client crosses one of the warning thresholds, or a
+// client stops being active.
+//
+// The watchdog is node-local: it only reads the state, and keeps the
+// thresholds crossed by each client in memory, so the warnings are logged
+// anew once the node restarts.
+type Watchdog struct {
+	querier    Querier
+	interval   uint64
+	thresholds []time.Duration
+
+	crossed  map[string]time.Duration
+	statuses map[string]string
+}
+
+// NewWatchdog creates a new Watchdog checking the clients every interval
+// blocks, 0 disabling it.
+func NewWatchdog(querier Querier, interval uint64, thresholds []time.Duration) *Watchdog {
+	thresholds = append([]time.Duration(nil), thresholds...)
+	sort.Slice(thresholds, func(i, j int) bool { return thresholds[i] > thresholds[j] })
+
+	return &Watchdog{
+		querier:    querier,
+		interval:   interval,
+		thresholds: thresholds,
+		crossed:    make(map[string]time.Duration),
+		statuses:   make(map[string]string),
+	}
+}
+
+// Check checks the health of the clients, if the block height is a multiple
+// of the interval.
+func (w *Watchdog) Check(ctx sdk.Context) {
+	if w.interval == 0 || uint64(ctx.BlockHeight())%w.interval != 0 {
+		return
+	}
+
+	logger := ctx.Logger().With("module", "x/"+types.ModuleName)
+	statusCounts := make(map[string]int)
+	for _, client := range w.querier.clientsHealth(ctx) {
+		statusCounts[client.Status]++
+
+		if previous, found := w.statuses[client.ClientId]; found && previous != client.Status {
+			if client.Status == exported.Active.String() {
+				logger.Info("IBC client is active again", "client_id", client.ClientId, "chain_id", client.ChainId)
+			} else {
+				logger.Error("IBC client is no longer active", "client_id", client.ClientId, "chain_id", client.ChainId, "status", client.Status)
+			}
+		}
+		w.statuses[client.ClientId] = client.Status
+
+		// the expired clients were reported along with their status
+		if !client.Expires || client.Status != exported.Active.String() {
+			continue
+		}
+		gaiatelemetry.SetIBCClientTimeToExpiry(ctx, client.ClientId, client.ChainId, client.TimeToExpiry)
+
+		threshold, crossed := w.crossedThreshold(client.TimeToExpiry)
+		if !crossed {
+			// the client was updated since it crossed a threshold
+			delete(w.crossed, client.ClientId)
+			continue
+		}
+		if previous, found := w.crossed[client.ClientId]; found && previous <= threshold {
+			continue
+		}
+		w.crossed[client.ClientId] = threshold
+
+		// the logger has no warning level, the warnings are logged as errors
+		// so that they show at the error log level
+		logger.Error("IBC client expires soon", "client_id", client.ClientId, "chain_id", client.ChainId,
+			"time_to_expiry", client.TimeToExpiry.Round(time.Second), "expiry", client.Expiry, "threshold", threshold)
+	}
+
+	for _, status := range []exported.Status{exported.Active, exported.Expired, exported.Frozen, exported.Unknown} {
+		gaiatelemetry.SetIBCClients(ctx, status.String(), statusCounts[status.String()])
+	}
+}
+
+// crossedThreshold returns the lowest warning threshold the time to expiry is
+// below, if any.
+func (w *Watchdog) crossedThreshold(timeToExpiry time.Duration) (time.Duration, bool) {
+	for i := len(w.thresholds) - 1; i >= 0; i-- {
+		if timeToExpiry < w.thresholds[i] {
+			return w.thresholds[i], true
+		}
+	}
+
+	return 0, false
+}
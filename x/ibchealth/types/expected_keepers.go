@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ClientKeeper defines the expected IBC client keeper.
+type ClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
+	GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool)
+	IterateClients(ctx sdk.Context, cb func(clientID string, cs exported.ClientState) bool)
+	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+}
+
+// ConnectionKeeper defines the expected IBC connection keeper.
+type ConnectionKeeper interface {
+	GetAllConnections(ctx sdk.Context) []connectiontypes.IdentifiedConnection
+}
+
+// ChannelKeeper defines the expected IBC channel keeper.
+type ChannelKeeper interface {
+	GetAllChannels(ctx sdk.Context) []channeltypes.IdentifiedChannel
+}
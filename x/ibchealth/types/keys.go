@@ -0,0 +1,4 @@
+package types
+
+// ModuleName defines the name of the IBC health query service.
+const ModuleName = "ibchealth"
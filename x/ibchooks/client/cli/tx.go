@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/ibchooks/types"
+)
+
+// GetTxCmd returns the transaction commands for the ibchooks module.
+func GetTxCmd() *cobra.Command {
+	ibchooksTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "IBC hooks transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	ibchooksTxCmd.AddCommand(
+		NewCmdGrantPermission(),
+		NewCmdRevokePermission(),
+	)
+
+	return ibchooksTxCmd
+}
+
+// NewCmdGrantPermission implements the command granting a hook permission.
+func NewCmdGrantPermission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [channel-id] [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Permit a sender on a counterparty chain to execute messages on your behalf through the memos of its transfers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Permit a sender on the counterparty chain of a channel to execute a message on
+your behalf when it transfers tokens to you through the channel, with a memo
+holding the message:
+
+{"hook":{"msg":{"@type":"/cosmos.staking.v1beta1.MsgDelegate","delegator_address":"<you>",...}}}
+
+Only the message types allowed by the params query can be executed. The
+transfer is reverted if the message fails.
+
+Example:
+$ %s tx %s grant channel-0 osmo1qnk2n4nlkpw9xfqntladh74w6ujtulwn7j8za9 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantHookPermission(clientCtx.GetFromAddress(), args[0], args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRevokePermission implements the command revoking a hook permission.
+func NewCmdRevokePermission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [channel-id] [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Revoke the permission of a sender on a counterparty chain to execute messages on your behalf",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeHookPermission(clientCtx.GetFromAddress(), args[0], args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
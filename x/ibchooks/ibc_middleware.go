@@ -0,0 +1,64 @@
+package ibchooks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/cosmos/gaia/v8/x/ibchooks/keeper"
+	"github.com/cosmos/gaia/v8/x/ibchooks/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the transfer IBC module to execute the hooks of the
+// memos of the received packets. The memos are stripped from the packets
+// passed to the wrapped module, whose packet data has no memo. All callbacks
+// other than OnRecvPacket are passed through to the wrapped module.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the given transfer
+// IBC module.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. The message of the hook of
+// a packet is executed once the transfer is received. If the hook is invalid
+// or fails, the packet is rejected with an error acknowledgement, which
+// reverts the transfer and refunds the tokens on the sender chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	data, ok := types.ParsePacketData(packet.GetData())
+	if !ok || data.Memo == "" {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	msg, err := im.keeper.HookMsg(ctx, packet.DestinationChannel, data)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+
+	packet.Data = data.TransferData()
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if msg == nil || ack == nil || !ack.Success() {
+		return ack
+	}
+
+	if err := im.keeper.ExecuteHook(ctx, packet.DestinationChannel, data, msg); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+
+	return ack
+}
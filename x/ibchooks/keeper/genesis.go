@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/ibchooks/types"
+)
+
+// InitGenesis initializes the ibchooks module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, permission := range genState.Permissions {
+		k.SetHookPermission(ctx, permission)
+	}
+}
+
+// ExportGenesis returns the ibchooks module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllHookPermissions(ctx))
+}
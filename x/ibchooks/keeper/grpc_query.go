@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/ibchooks/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// HookPermissions implements the Query/HookPermissions gRPC method.
+func (k Keeper) HookPermissions(c context.Context, req *types.QueryHookPermissionsRequest) (*types.QueryHookPermissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	receiver, err := sdk.AccAddressFromBech32(req.Receiver)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid receiver address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryHookPermissionsResponse{Permissions: k.GetHookPermissions(ctx, receiver)}, nil
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/gaia/v8/x/ibchooks/types"
+)
+
+// HookMsg returns the message of the hook of a transfer received through a
+// channel, if any. The message must be of an allowed type, signed by the
+// receiver only, and the receiver must have granted the sender the permission
+// to execute hooks through the channel.
+func (k Keeper) HookMsg(ctx sdk.Context, channelID string, data types.PacketData) (sdk.Msg, error) {
+	hook, err := data.Hook()
+	if err != nil || hook == nil {
+		return nil, err
+	}
+
+	var msg sdk.Msg
+	if err := k.cdc.UnmarshalInterfaceJSON(hook.Msg, &msg); err != nil {
+		return nil, types.ErrInvalidHook.Wrapf("cannot decode hook message: %s", err)
+	}
+	msgType := sdk.MsgTypeURL(msg)
+	if !k.GetParams(ctx).IsMsgTypeAllowed(msgType) {
+		return nil, sdkerrors.Wrap(types.ErrMsgNotAllowed, msgType)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidHook, err.Error())
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidHook, "invalid receiver address: %s", err)
+	}
+	signers := msg.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(receiver) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "hook message must be signed by the receiver %s only", receiver)
+	}
+	if !k.HasHookPermission(ctx, receiver, channelID, data.Sender) {
+		return nil, sdkerrors.Wrapf(types.ErrPermissionNotFound, "%s did not permit %s to execute hooks through %s", receiver, data.Sender, channelID)
+	}
+
+	return msg, nil
+}
+
+// ExecuteHook executes the message of a hook of a transfer received through
+// a channel.
+func (k Keeper) ExecuteHook(ctx sdk.Context, channelID string, data types.PacketData, msg sdk.Msg) error {
+	handler := k.msgRouter.Handler(msg)
+	if handler == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no handler for %s", sdk.MsgTypeURL(msg))
+	}
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrHookExecutionFailed, err.Error())
+	}
+
+	// the handler emits its events on a new event manager
+	ctx.EventManager().EmitEvents(res.GetEvents())
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeHookExecuted,
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+		sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+		sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyMsgType, sdk.MsgTypeURL(msg)),
+	))
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v8/app/helpers"
+	"github.com/cosmos/gaia/v8/x/ibchooks"
+	"github.com/cosmos/gaia/v8/x/ibchooks/keeper"
+	"github.com/cosmos/gaia/v8/x/ibchooks/types"
+)
+
+const remoteSender = "osmo1qnk2n4nlkpw9xfqntladh74w6ujtulwn7j8za9"
+
+// hookPacket returns a packet returning bond denom tokens to the Hub through
+// channel-0, with a memo.
+func hookPacket(bondDenom string, receiver sdk.AccAddress, amount int64, memo string) channeltypes.Packet {
+	data, err := json.Marshal(types.PacketData{
+		Denom:    "transfer/channel-7/" + bondDenom,
+		Amount:   fmt.Sprint(amount),
+		Sender:   remoteSender,
+		Receiver: receiver.String(),
+		Memo:     memo,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return channeltypes.NewPacket(data, 1, transfertypes.PortID, "channel-7", transfertypes.PortID, "channel-0", clienttypes.NewHeight(0, 100), 0)
+}
+
+func delegateMemo(delegator sdk.AccAddress, validator sdk.ValAddress, amount sdk.Coin) string {
+	return fmt.Sprintf(`{"hook":{"msg":{"@type":"/cosmos.staking.v1beta1.MsgDelegate","delegator_address":"%s","validator_address":"%s","amount":{"denom":"%s","amount":"%s"}}}}`,
+		delegator, validator, amount.Denom, amount.Amount)
+}
+
+func TestHooks(t *testing.T) {
+	app := gaiahelpers.Setup(t, false, 0)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	k := app.IBCHooksKeeper
+	middleware := ibchooks.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), k)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	valAddr := app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+	receiver := sdk.AccAddress("receiver____________")
+	other := sdk.AccAddress("other_______________")
+
+	// escrow the bond denom tokens returning through channel-0
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10_000_000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, escrow, coins))
+
+	delegation := sdk.NewInt64Coin(bondDenom, 1_000_000)
+	memo := delegateMemo(receiver, valAddr, delegation)
+
+	// hooks require the permission of the receiver
+	ack := middleware.OnRecvPacket(ctx, hookPacket(bondDenom, receiver, 1_000_000, memo), nil)
+	require.False(t, ack.Success())
+	require.Contains(t, string(ack.Acknowledgement()), "did not permit")
+
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.GrantHookPermission(sdk.WrapSDKContext(ctx), types.NewMsgGrantHookPermission(receiver, "channel-0", remoteSender))
+	require.NoError(t, err)
+	require.Len(t, k.GetHookPermissions(ctx, receiver), 1)
+
+	// the received tokens are delegated
+	ack = middleware.OnRecvPacket(ctx, hookPacket(bondDenom, receiver, 1_000_000, memo), nil)
+	require.True(t, ack.Success(), string(ack.Acknowledgement()))
+	_, found := app.StakingKeeper.GetDelegation(ctx, receiver, valAddr)
+	require.True(t, found)
+	require.True(t, app.BankKeeper.GetBalance(ctx, receiver, bondDenom).IsZero())
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeHookExecuted, events[len(events)-1].Type)
+
+	// failed hooks are rejected with an error acknowledgement, reverting the
+	// transfer within the cached context of the core IBC handler
+	cacheCtx, _ := ctx.CacheContext()
+	ack = middleware.OnRecvPacket(cacheCtx, hookPacket(bondDenom, receiver, 1_000, memo), nil)
+	require.False(t, ack.Success())
+	require.Contains(t, string(ack.Acknowledgement()), "hook execution failed")
+
+	// only the allowed messages signed by the receiver are executed
+	send := fmt.Sprintf(`{"hook":{"msg":{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"%s","to_address":"%s","amount":[]}}}`, receiver, other)
+	ack = middleware.OnRecvPacket(ctx, hookPacket(bondDenom, receiver, 1_000, send), nil)
+	require.False(t, ack.Success())
+	require.Contains(t, string(ack.Acknowledgement()), "message type not allowed")
+	ack = middleware.OnRecvPacket(ctx, hookPacket(bondDenom, receiver, 1_000, delegateMemo(other, valAddr, delegation)), nil)
+	require.False(t, ack.Success())
+	require.Contains(t, string(ack.Acknowledgement()), "signed by the receiver")
+
+	// the memos without hooks are stripped and ignored
+	ack = middleware.OnRecvPacket(ctx, hookPacket(bondDenom, other, 1_000, "thanks"), nil)
+	require.True(t, ack.Success(), string(ack.Acknowledgement()))
+	require.Equal(t, int64(1_000), app.BankKeeper.GetBalance(ctx, other, bondDenom).Amount.Int64())
+
+	// revoked permissions no longer execute hooks
+	_, err = msgServer.RevokeHookPermission(sdk.WrapSDKContext(ctx), types.NewMsgRevokeHookPermission(receiver, "channel-0", remoteSender))
+	require.NoError(t, err)
+	ack = middleware.OnRecvPacket(ctx, hookPacket(bondDenom, receiver, 1_000_000, memo), nil)
+	require.False(t, ack.Success())
+
+	genState := k.ExportGenesis(ctx)
+	require.NoError(t, genState.Validate())
+	require.Empty(t, genState.Permissions)
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/ibchooks/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the ibchooks MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// GrantHookPermission implements the Msg/GrantHookPermission method.
+func (k msgServer) GrantHookPermission(goCtx context.Context, msg *types.MsgGrantHookPermission) (*types.MsgGrantHookPermissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetHookPermission(ctx, types.NewHookPermission(receiver, msg.ChannelId, msg.Sender))
+
+	return &types.MsgGrantHookPermissionResponse{}, nil
+}
+
+// RevokeHookPermission implements the Msg/RevokeHookPermission method.
+func (k msgServer) RevokeHookPermission(goCtx context.Context, msg *types.MsgRevokeHookPermission) (*types.MsgRevokeHookPermissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	if !k.HasHookPermission(ctx, receiver, msg.ChannelId, msg.Sender) {
+		return nil, types.ErrPermissionNotFound
+	}
+
+	k.DeleteHookPermission(ctx, receiver, msg.ChannelId, msg.Sender)
+
+	return &types.MsgRevokeHookPermissionResponse{}, nil
+}
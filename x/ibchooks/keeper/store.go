@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/ibchooks/types"
+)
+
+// SetHookPermission sets a hook permission.
+func (k Keeper) SetHookPermission(ctx sdk.Context, permission types.HookPermission) {
+	receiver, err := sdk.AccAddressFromBech32(permission.Receiver)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HookPermissionKey(receiver, permission.ChannelId, permission.Sender), k.cdc.MustMarshal(&permission))
+}
+
+// HasHookPermission returns whether a receiver granted a hook permission to a
+// sender through a channel.
+func (k Keeper) HasHookPermission(ctx sdk.Context, receiver sdk.AccAddress, channelID, sender string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.HookPermissionKey(receiver, channelID, sender))
+}
+
+// DeleteHookPermission deletes the hook permission granted by a receiver to a
+// sender through a channel.
+func (k Keeper) DeleteHookPermission(ctx sdk.Context, receiver sdk.AccAddress, channelID, sender string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.HookPermissionKey(receiver, channelID, sender))
+}
+
+// GetHookPermissions returns the hook permissions granted by a receiver.
+func (k Keeper) GetHookPermissions(ctx sdk.Context, receiver sdk.AccAddress) []types.HookPermission {
+	return k.getHookPermissions(ctx, types.HookPermissionsPrefix(receiver))
+}
+
+// GetAllHookPermissions returns all the hook permissions.
+func (k Keeper) GetAllHookPermissions(ctx sdk.Context) []types.HookPermission {
+	return k.getHookPermissions(ctx, types.HookPermissionKeyPrefix)
+}
+
+func (k Keeper) getHookPermissions(ctx sdk.Context, prefix []byte) []types.HookPermission {
+	var permissions []types.HookPermission
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var permission types.HookPermission
+		k.cdc.MustUnmarshal(iterator.Value(), &permission)
+		permissions = append(permissions, permission)
+	}
+
+	return permissions
+}
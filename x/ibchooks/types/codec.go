@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the x/ibchooks messages on the
+// provided LegacyAmino codec, for Amino JSON signing.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgGrantHookPermission{}, "gaia/MsgGrantHookPermission")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeHookPermission{}, "gaia/MsgRevokeHookPermission")
+}
+
+// RegisterInterfaces registers the x/ibchooks messages with the interface
+// registry.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrantHookPermission{},
+		&MsgRevokeHookPermission{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/ibchooks module codec, only used
+	// for Amino JSON encoding.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+
+	// register the messages on the global Amino codec as well, so that they
+	// can be signed within authz MsgExec messages
+	RegisterLegacyAminoCodec(legacy.Cdc)
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/ibchooks errors
+var (
+	ErrInvalidHook         = sdkerrors.Register(ModuleName, 2, "invalid hook")
+	ErrMsgNotAllowed       = sdkerrors.Register(ModuleName, 3, "message type not allowed in hooks")
+	ErrPermissionNotFound  = sdkerrors.Register(ModuleName, 4, "hook permission not found")
+	ErrHookExecutionFailed = sdkerrors.Register(ModuleName, 5, "hook execution failed")
+)
@@ -0,0 +1,11 @@
+package types
+
+// ibchooks module event types and attributes
+const (
+	EventTypeHookExecuted = "ibc_hook_executed"
+
+	AttributeKeyReceiver = "receiver"
+	AttributeKeyChannel  = "channel"
+	AttributeKeySender   = "sender"
+	AttributeKeyMsgType  = "msg_type"
+)
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
+)
+
+// MsgRouter defines the expected router of the messages executed by hooks.
+type MsgRouter interface {
+	Handler(msg sdk.Msg) authmiddleware.MsgServiceHandler
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState instance.
+func NewGenesisState(params Params, permissions []HookPermission) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		Permissions: permissions,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the ibchooks
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	permissions := make(map[HookPermission]bool, len(gs.Permissions))
+	for _, permission := range gs.Permissions {
+		if err := permission.Validate(); err != nil {
+			return fmt.Errorf("invalid hook permission of %s: %w", permission.Receiver, err)
+		}
+		if permissions[permission] {
+			return fmt.Errorf("duplicate hook permission of %s to %s through %s", permission.Receiver, permission.Sender, permission.ChannelId)
+		}
+		permissions[permission] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the name of the ibchooks module.
+	ModuleName = "ibchooks"
+
+	// StoreKey defines the primary module store key, which must not be
+	// prefixed by the ibc store key.
+	StoreKey = "hooks"
+
+	// RouterKey defines the module's message routing key.
+	RouterKey = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// HookPermissionKeyPrefix prefixes the hook permissions, by receiver,
+	// channel and sender.
+	HookPermissionKeyPrefix = []byte{0x01}
+)
+
+// HookPermissionsPrefix returns the prefix of the hook permissions granted by
+// a receiver.
+func HookPermissionsPrefix(receiver []byte) []byte {
+	return append(HookPermissionKeyPrefix, address.MustLengthPrefix(receiver)...)
+}
+
+// HookPermissionKey returns the key of the hook permission granted by a
+// receiver to a sender through a channel.
+func HookPermissionKey(receiver []byte, channelID, sender string) []byte {
+	key := append(HookPermissionsPrefix(receiver), address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, address.MustLengthPrefix([]byte(sender))...)
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// PacketData is the data of an ICS-20 packet along with its memo, which the
+// transfer module of the Hub does not decode.
+type PacketData struct {
+	Denom    string `json:"denom"`
+	Amount   string `json:"amount"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Memo     string `json:"memo,omitempty"`
+}
+
+// Memo is the structure of the memos executing hooks, e.g.
+//
+//	{"hook":{"msg":{"@type":"/cosmos.staking.v1beta1.MsgDelegate",...}}}
+//
+// Memos without a hook are ignored.
+type Memo struct {
+	Hook *Hook `json:"hook,omitempty"`
+}
+
+// Hook is a message executed on behalf of the receiver of a transfer, once
+// the transfer is received.
+type Hook struct {
+	Msg json.RawMessage `json:"msg"`
+}
+
+// ParsePacketData decodes the data of an ICS-20 packet along with its memo.
+// It returns false if the data is not the one of an ICS-20 packet.
+func ParsePacketData(bz []byte) (PacketData, bool) {
+	var data PacketData
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&data); err != nil {
+		return PacketData{}, false
+	}
+
+	return data, true
+}
+
+// TransferData returns the packet data without its memo, as decoded by the
+// transfer module.
+func (d PacketData) TransferData() []byte {
+	return transfertypes.NewFungibleTokenPacketData(d.Denom, d.Amount, d.Sender, d.Receiver).GetBytes()
+}
+
+// Hook returns the hook of the memo of the packet data, if any.
+func (d PacketData) Hook() (*Hook, error) {
+	// only JSON object memos may hold a hook
+	if !strings.HasPrefix(strings.TrimSpace(d.Memo), "{") {
+		return nil, nil
+	}
+
+	var memo Memo
+	if err := json.Unmarshal([]byte(d.Memo), &memo); err != nil {
+		return nil, ErrInvalidHook.Wrapf("cannot decode memo: %s", err)
+	}
+	if memo.Hook != nil && len(memo.Hook.Msg) == 0 {
+		return nil, ErrInvalidHook.Wrap("missing hook message")
+	}
+
+	return memo.Hook, nil
+}
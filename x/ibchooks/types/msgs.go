@@ -0,0 +1,97 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// ibchooks message types
+const (
+	TypeMsgGrantHookPermission  = "grant_hook_permission"
+	TypeMsgRevokeHookPermission = "revoke_hook_permission"
+)
+
+var (
+	_ sdk.Msg            = &MsgGrantHookPermission{}
+	_ sdk.Msg            = &MsgRevokeHookPermission{}
+	_ legacytx.LegacyMsg = &MsgGrantHookPermission{}
+	_ legacytx.LegacyMsg = &MsgRevokeHookPermission{}
+)
+
+// NewMsgGrantHookPermission creates a new MsgGrantHookPermission instance.
+func NewMsgGrantHookPermission(receiver sdk.AccAddress, channelID, sender string) *MsgGrantHookPermission {
+	return &MsgGrantHookPermission{
+		Receiver:  receiver.String(),
+		ChannelId: channelID,
+		Sender:    sender,
+	}
+}
+
+// Route implements the legacytx.LegacyMsg interface.
+func (msg MsgGrantHookPermission) Route() string { return RouterKey }
+
+// Type implements the legacytx.LegacyMsg interface.
+func (msg MsgGrantHookPermission) Type() string { return TypeMsgGrantHookPermission }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgGrantHookPermission) GetSigners() []sdk.AccAddress {
+	receiver, _ := sdk.AccAddressFromBech32(msg.Receiver)
+	return []sdk.AccAddress{receiver}
+}
+
+// GetSignBytes implements the legacytx.LegacyMsg interface.
+func (msg MsgGrantHookPermission) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrantHookPermission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid receiver address: %s", err)
+	}
+	if err := validateChannelAndSender(msg.ChannelId, msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgRevokeHookPermission creates a new MsgRevokeHookPermission instance.
+func NewMsgRevokeHookPermission(receiver sdk.AccAddress, channelID, sender string) *MsgRevokeHookPermission {
+	return &MsgRevokeHookPermission{
+		Receiver:  receiver.String(),
+		ChannelId: channelID,
+		Sender:    sender,
+	}
+}
+
+// Route implements the legacytx.LegacyMsg interface.
+func (msg MsgRevokeHookPermission) Route() string { return RouterKey }
+
+// Type implements the legacytx.LegacyMsg interface.
+func (msg MsgRevokeHookPermission) Type() string { return TypeMsgRevokeHookPermission }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevokeHookPermission) GetSigners() []sdk.AccAddress {
+	receiver, _ := sdk.AccAddressFromBech32(msg.Receiver)
+	return []sdk.AccAddress{receiver}
+}
+
+// GetSignBytes implements the legacytx.LegacyMsg interface.
+func (msg MsgRevokeHookPermission) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeHookPermission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid receiver address: %s", err)
+	}
+	if err := validateChannelAndSender(msg.ChannelId, msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
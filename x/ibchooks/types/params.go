@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"sigs.k8s.io/yaml"
+)
+
+// Default parameter values
+var (
+	DefaultAllowedMsgTypes = []string{
+		sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		sdk.MsgTypeURL(&transfertypes.MsgTransfer{}),
+	}
+)
+
+// Parameter store keys
+var (
+	KeyAllowedMsgTypes = []byte("AllowedMsgTypes")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table of the ibchooks module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(allowedMsgTypes []string) Params {
+	return Params{
+		AllowedMsgTypes: allowedMsgTypes,
+	}
+}
+
+// DefaultParams returns the default parameters of the ibchooks module, which
+// allow delegating and forwarding the received tokens.
+func DefaultParams() Params {
+	return NewParams(DefaultAllowedMsgTypes)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAllowedMsgTypes, &p.AllowedMsgTypes, validateAllowedMsgTypes),
+	}
+}
+
+// Validate validates the parameters.
+func (p Params) Validate() error {
+	if err := validateAllowedMsgTypes(p.AllowedMsgTypes); err != nil {
+		return fmt.Errorf("invalid allowed message types: %w", err)
+	}
+
+	return nil
+}
+
+// IsMsgTypeAllowed returns whether a message type URL is allowed in hooks.
+func (p Params) IsMsgTypeAllowed(msgType string) bool {
+	for _, allowed := range p.AllowedMsgTypes {
+		if allowed == msgType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateAllowedMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	msgTypes := make(map[string]bool, len(v))
+	for _, msgType := range v {
+		if len(msgType) < 2 || msgType[0] != '/' {
+			return fmt.Errorf("invalid message type URL %q", msgType)
+		}
+		if msgTypes[msgType] {
+			return fmt.Errorf("duplicate message type %s", msgType)
+		}
+		msgTypes[msgType] = true
+	}
+
+	return nil
+}
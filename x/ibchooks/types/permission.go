@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewHookPermission creates a new HookPermission instance.
+func NewHookPermission(receiver sdk.AccAddress, channelID, sender string) HookPermission {
+	return HookPermission{
+		Receiver:  receiver.String(),
+		ChannelId: channelID,
+		Sender:    sender,
+	}
+}
+
+// Validate validates the permission.
+func (p HookPermission) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Receiver); err != nil {
+		return fmt.Errorf("invalid receiver address: %w", err)
+	}
+
+	return validateChannelAndSender(p.ChannelId, p.Sender)
+}
+
+func validateChannelAndSender(channelID, sender string) error {
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return err
+	}
+	if strings.TrimSpace(sender) == "" {
+		return fmt.Errorf("sender cannot be blank")
+	}
+
+	return nil
+}
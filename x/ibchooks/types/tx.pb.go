@@ -0,0 +1,1035 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/ibchooks/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgGrantHookPermission is the Msg/GrantHookPermission request type.
+type MsgGrantHookPermission struct {
+	// receiver is the address of the receiver granting the permission.
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// channel_id is the id of the channel on the Hub.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sender is the address of the sender on the counterparty chain.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgGrantHookPermission) Reset()         { *m = MsgGrantHookPermission{} }
+func (m *MsgGrantHookPermission) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantHookPermission) ProtoMessage()    {}
+func (*MsgGrantHookPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3445562d402253bb, []int{0}
+}
+func (m *MsgGrantHookPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantHookPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantHookPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantHookPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantHookPermission.Merge(m, src)
+}
+func (m *MsgGrantHookPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantHookPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantHookPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantHookPermission proto.InternalMessageInfo
+
+// MsgGrantHookPermissionResponse is the Msg/GrantHookPermission response
+// type.
+type MsgGrantHookPermissionResponse struct {
+}
+
+func (m *MsgGrantHookPermissionResponse) Reset()         { *m = MsgGrantHookPermissionResponse{} }
+func (m *MsgGrantHookPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantHookPermissionResponse) ProtoMessage()    {}
+func (*MsgGrantHookPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3445562d402253bb, []int{1}
+}
+func (m *MsgGrantHookPermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantHookPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantHookPermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantHookPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantHookPermissionResponse.Merge(m, src)
+}
+func (m *MsgGrantHookPermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantHookPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantHookPermissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantHookPermissionResponse proto.InternalMessageInfo
+
+// MsgRevokeHookPermission is the Msg/RevokeHookPermission request type.
+type MsgRevokeHookPermission struct {
+	// receiver is the address of the receiver revoking the permission.
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// channel_id is the id of the channel on the Hub.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sender is the address of the sender on the counterparty chain.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgRevokeHookPermission) Reset()         { *m = MsgRevokeHookPermission{} }
+func (m *MsgRevokeHookPermission) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeHookPermission) ProtoMessage()    {}
+func (*MsgRevokeHookPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3445562d402253bb, []int{2}
+}
+func (m *MsgRevokeHookPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeHookPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeHookPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeHookPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeHookPermission.Merge(m, src)
+}
+func (m *MsgRevokeHookPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeHookPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeHookPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeHookPermission proto.InternalMessageInfo
+
+// MsgRevokeHookPermissionResponse is the Msg/RevokeHookPermission response
+// type.
+type MsgRevokeHookPermissionResponse struct {
+}
+
+func (m *MsgRevokeHookPermissionResponse) Reset()         { *m = MsgRevokeHookPermissionResponse{} }
+func (m *MsgRevokeHookPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeHookPermissionResponse) ProtoMessage()    {}
+func (*MsgRevokeHookPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3445562d402253bb, []int{3}
+}
+func (m *MsgRevokeHookPermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeHookPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeHookPermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeHookPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeHookPermissionResponse.Merge(m, src)
+}
+func (m *MsgRevokeHookPermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeHookPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeHookPermissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeHookPermissionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgGrantHookPermission)(nil), "gaia.ibchooks.v1beta1.MsgGrantHookPermission")
+	proto.RegisterType((*MsgGrantHookPermissionResponse)(nil), "gaia.ibchooks.v1beta1.MsgGrantHookPermissionResponse")
+	proto.RegisterType((*MsgRevokeHookPermission)(nil), "gaia.ibchooks.v1beta1.MsgRevokeHookPermission")
+	proto.RegisterType((*MsgRevokeHookPermissionResponse)(nil), "gaia.ibchooks.v1beta1.MsgRevokeHookPermissionResponse")
+}
+
+func init() { proto.RegisterFile("gaia/ibchooks/v1beta1/tx.proto", fileDescriptor_3445562d402253bb) }
+
+var fileDescriptor_3445562d402253bb = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xb1, 0x4b, 0xc3, 0x40,
+	0x14, 0xc6, 0x93, 0x16, 0x4a, 0xfb, 0xc6, 0x58, 0x6b, 0x09, 0x78, 0xad, 0x9d, 0x44, 0xf0, 0x8e,
+	0x2a, 0x8a, 0x38, 0x8a, 0xa0, 0x0e, 0x05, 0xe9, 0xe8, 0x22, 0x49, 0xfa, 0xb8, 0x1e, 0xb5, 0x79,
+	0x25, 0x2f, 0x0d, 0x15, 0xc1, 0xc1, 0xc9, 0xd1, 0x3f, 0xa1, 0x7f, 0x8e, 0x63, 0x47, 0x47, 0x69,
+	0x17, 0xff, 0x0c, 0x69, 0x6c, 0x74, 0x49, 0x87, 0x2e, 0x6e, 0x79, 0xf9, 0x7e, 0xdc, 0xf7, 0xdd,
+	0xbb, 0x0f, 0x84, 0xf6, 0x8c, 0xa7, 0x8c, 0x1f, 0xf4, 0x89, 0x06, 0xac, 0x92, 0xb6, 0x8f, 0xb1,
+	0xd7, 0x56, 0xf1, 0x44, 0x8e, 0x22, 0x8a, 0xc9, 0xd9, 0x5e, 0xea, 0x32, 0xd3, 0xe5, 0x4a, 0x77,
+	0xab, 0x9a, 0x34, 0xa5, 0x84, 0x5a, 0x7e, 0xfd, 0xc0, 0xad, 0x31, 0xd4, 0x3a, 0xac, 0xaf, 0x22,
+	0x2f, 0x8c, 0xaf, 0x89, 0x06, 0xb7, 0x18, 0x0d, 0x0d, 0xb3, 0xa1, 0xd0, 0x71, 0xa1, 0x1c, 0x61,
+	0x80, 0x26, 0xc1, 0xa8, 0x6e, 0x37, 0xed, 0xfd, 0x4a, 0xf7, 0x77, 0x76, 0x76, 0x01, 0x82, 0xbe,
+	0x17, 0x86, 0xf8, 0x70, 0x6f, 0x7a, 0xf5, 0x42, 0xaa, 0x56, 0x56, 0x7f, 0x6e, 0x7a, 0x4e, 0x0d,
+	0x4a, 0x8c, 0x61, 0x0f, 0xa3, 0x7a, 0x31, 0x95, 0x56, 0xd3, 0x79, 0xf9, 0x75, 0xda, 0xb0, 0xbe,
+	0xa6, 0x0d, 0xab, 0xd5, 0x04, 0x91, 0x6f, 0xdb, 0x45, 0x1e, 0x51, 0xc8, 0xd8, 0x4a, 0x60, 0xa7,
+	0xc3, 0xba, 0x8b, 0x09, 0x0d, 0xf0, 0x3f, 0x93, 0xed, 0x41, 0x63, 0x8d, 0x6f, 0x16, 0xed, 0xe8,
+	0xa5, 0x00, 0xc5, 0x0e, 0x6b, 0xe7, 0x09, 0xb6, 0xf2, 0x16, 0x77, 0x28, 0x73, 0x1f, 0x40, 0xe6,
+	0x5f, 0xd8, 0x3d, 0xd9, 0x08, 0xcf, 0x42, 0x38, 0xcf, 0x50, 0xcd, 0x5d, 0x8e, 0x5c, 0x7f, 0x5c,
+	0x1e, 0xef, 0x9e, 0x6e, 0xc6, 0x67, 0xfe, 0x17, 0x97, 0xef, 0x73, 0x61, 0xcf, 0xe6, 0xc2, 0xfe,
+	0x9c, 0x0b, 0xfb, 0x6d, 0x21, 0xac, 0xd9, 0x42, 0x58, 0x1f, 0x0b, 0x61, 0xdd, 0x1d, 0x68, 0x13,
+	0xf7, 0xc7, 0xbe, 0x0c, 0x68, 0xa8, 0x02, 0xe2, 0x21, 0xb1, 0x4a, 0x1b, 0x9b, 0x9c, 0xa9, 0xc9,
+	0x5f, 0x6d, 0xe3, 0xc7, 0x11, 0xb2, 0x5f, 0x4a, 0x5b, 0x78, 0xfc, 0x3d, 0x00, 0xe0, 0x37, 0x67,
+	0x07, 0xd4, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// GrantHookPermission permits a sender on a counterparty chain to execute
+	// messages on behalf of the receiver through the memos of its transfers.
+	GrantHookPermission(ctx context.Context, in *MsgGrantHookPermission, opts ...grpc.CallOption) (*MsgGrantHookPermissionResponse, error)
+	// RevokeHookPermission revokes a permission granted to a sender.
+	RevokeHookPermission(ctx context.Context, in *MsgRevokeHookPermission, opts ...grpc.CallOption) (*MsgRevokeHookPermissionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) GrantHookPermission(ctx context.Context, in *MsgGrantHookPermission, opts ...grpc.CallOption) (*MsgGrantHookPermissionResponse, error) {
+	out := new(MsgGrantHookPermissionResponse)
+	err := c.cc.Invoke(ctx, "/gaia.ibchooks.v1beta1.Msg/GrantHookPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeHookPermission(ctx context.Context, in *MsgRevokeHookPermission, opts ...grpc.CallOption) (*MsgRevokeHookPermissionResponse, error) {
+	out := new(MsgRevokeHookPermissionResponse)
+	err := c.cc.Invoke(ctx, "/gaia.ibchooks.v1beta1.Msg/RevokeHookPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// GrantHookPermission permits a sender on a counterparty chain to execute
+	// messages on behalf of the receiver through the memos of its transfers.
+	GrantHookPermission(context.Context, *MsgGrantHookPermission) (*MsgGrantHookPermissionResponse, error)
+	// RevokeHookPermission revokes a permission granted to a sender.
+	RevokeHookPermission(context.Context, *MsgRevokeHookPermission) (*MsgRevokeHookPermissionResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) GrantHookPermission(ctx context.Context, req *MsgGrantHookPermission) (*MsgGrantHookPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantHookPermission not implemented")
+}
+func (*UnimplementedMsgServer) RevokeHookPermission(ctx context.Context, req *MsgRevokeHookPermission) (*MsgRevokeHookPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeHookPermission not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_GrantHookPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantHookPermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantHookPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.ibchooks.v1beta1.Msg/GrantHookPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantHookPermission(ctx, req.(*MsgGrantHookPermission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeHookPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeHookPermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeHookPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.ibchooks.v1beta1.Msg/RevokeHookPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeHookPermission(ctx, req.(*MsgRevokeHookPermission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.ibchooks.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GrantHookPermission",
+			Handler:    _Msg_GrantHookPermission_Handler,
+		},
+		{
+			MethodName: "RevokeHookPermission",
+			Handler:    _Msg_RevokeHookPermission_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/ibchooks/v1beta1/tx.proto",
+}
+
+func (m *MsgGrantHookPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantHookPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantHookPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantHookPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantHookPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantHookPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeHookPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeHookPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeHookPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeHookPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeHookPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeHookPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrantHookPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantHookPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeHookPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeHookPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGrantHookPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantHookPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantHookPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantHookPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantHookPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantHookPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeHookPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeHookPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeHookPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeHookPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeHookPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeHookPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
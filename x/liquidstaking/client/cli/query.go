@@ -0,0 +1,272 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/liquidstaking/types"
+)
+
+// GetQueryCmd returns the cli query commands for the liquidstaking module.
+func GetQueryCmd() *cobra.Command {
+	liquidstakingQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the liquidstaking module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	liquidstakingQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryRecord(),
+		GetCmdQueryRecordByDenom(),
+		GetCmdQueryRecords(),
+		GetCmdQueryRecordsOwned(),
+		GetCmdQueryTotalLiquidStaked(),
+		GetCmdQueryValidatorLiquidStaked(),
+		GetCmdQueryValidatorBonds(),
+	)
+
+	return liquidstakingQueryCmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the liquid staking parameters",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRecord implements the record query command.
+func GetCmdQueryRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a tokenize share record by id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid record id %q: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenizeShareRecord(cmd.Context(), &types.QueryTokenizeShareRecordRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRecordByDenom implements the record-by-denom query command.
+func GetCmdQueryRecordByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-by-denom [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tokenize share record of a share token denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenizeShareRecordByDenom(cmd.Context(), &types.QueryTokenizeShareRecordByDenomRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRecords implements the records query command.
+func GetCmdQueryRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "records",
+		Args:  cobra.NoArgs,
+		Short: "Query all the tokenize share records",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenizeShareRecords(cmd.Context(), &types.QueryTokenizeShareRecordsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "records")
+
+	return cmd
+}
+
+// GetCmdQueryRecordsOwned implements the records-owned query command.
+func GetCmdQueryRecordsOwned() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "records-owned [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tokenize share records owned by an address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenizeShareRecordsOwned(cmd.Context(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTotalLiquidStaked implements the total-liquid-staked query
+// command.
+func GetCmdQueryTotalLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query the amount of tokenized bond denom tokens and of bonded tokens",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStaked(cmd.Context(), &types.QueryTotalLiquidStakedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorLiquidStaked implements the validator query command.
+func GetCmdQueryValidatorLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the liquid delegator shares and the validator bond shares of a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorLiquidStaked(cmd.Context(), &types.QueryValidatorLiquidStakedRequest{ValidatorAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorBonds implements the validator bonds query command.
+func GetCmdQueryValidatorBonds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-bonds [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validator bonds of a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorBonds(cmd.Context(), &types.QueryValidatorBondsRequest{ValidatorAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
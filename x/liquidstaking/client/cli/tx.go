@@ -0,0 +1,222 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/liquidstaking/types"
+)
+
+// GetTxCmd returns the transaction commands for the liquidstaking module.
+func GetTxCmd() *cobra.Command {
+	liquidstakingTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Liquid staking transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	liquidstakingTxCmd.AddCommand(
+		NewCmdTokenizeShares(),
+		NewCmdRedeemTokens(),
+		NewCmdTransferRecord(),
+		NewCmdWithdrawRecordReward(),
+		NewCmdValidatorBond(),
+	)
+
+	return liquidstakingTxCmd
+}
+
+// NewCmdTokenizeShares implements the command tokenizing a delegation.
+func NewCmdTokenizeShares() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share [validator] [amount] [owner]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Tokenize a part of a delegation into transferable share tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Tokenize an amount of bond denom tokens of a delegation to a validator into
+share tokens, whose denom is the validator operator address followed by the
+id of the created tokenize share record. The share tokens can be transferred
+and redeemed for a delegation by their holder, while the owner of the record
+receives the rewards of the tokenized delegation.
+
+Example:
+$ %s tx %s tokenize-share cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0 1000000uatom cosmos1qnk2n4nlkpw9xfqntladh74w6ujtulwn7j8za9 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			owner, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeShares(clientCtx.GetFromAddress(), validator, amount, owner)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRedeemTokens implements the command redeeming share tokens.
+func NewCmdRedeemTokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-tokens [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Redeem share tokens for a delegation to their validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem share tokens for a delegation of the delegator shares they represent.
+
+Example:
+$ %s tx %s redeem-tokens 1000000cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0/1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemTokensForShares(clientCtx.GetFromAddress(), amount)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdTransferRecord implements the command transferring the ownership of
+// a tokenize share record.
+func NewCmdTransferRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-record [id] [new-owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Transfer the ownership of a tokenize share record, and so its rewards",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid record id %q: %w", args[0], err)
+			}
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferTokenizeShareRecord(id, clientCtx.GetFromAddress(), newOwner)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdWithdrawRecordReward implements the command withdrawing the rewards
+// of a tokenize share record.
+func NewCmdWithdrawRecordReward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-record-reward [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Withdraw the rewards of the delegation of a tokenize share record",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid record id %q: %w", args[0], err)
+			}
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(clientCtx.GetFromAddress(), id)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdValidatorBond implements the command designating a delegation as a
+// validator bond.
+func NewCmdValidatorBond() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-bond [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Designate a delegation to a validator as a validator bond",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Designate the delegation of the sender to a validator as a validator bond.
+The liquid shares a validator can receive are limited to its validator bond
+shares multiplied by the validator bond factor. A validator bond cannot be
+tokenized, and cannot be undelegated below what the liquid shares of the
+validator require.
+
+Example:
+$ %s tx %s validator-bond cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgValidatorBond(clientCtx.GetFromAddress(), validator)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/liquidstaking/types"
+)
+
+// InitGenesis initializes the liquidstaking module's state from a genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, record := range genState.Records {
+		k.SetRecord(ctx, record)
+	}
+	k.SetLastRecordID(ctx, genState.LastRecordId)
+	for _, shares := range genState.ValidatorLiquidShares {
+		valAddr, err := sdk.ValAddressFromBech32(shares.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorLiquidShares(ctx, valAddr, shares.LiquidShares)
+	}
+	for _, bond := range genState.ValidatorBonds {
+		k.SetValidatorBond(ctx, bond)
+	}
+}
+
+// ExportGenesis returns the liquidstaking module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(
+		k.GetParams(ctx),
+		k.GetAllRecords(ctx),
+		k.GetLastRecordID(ctx),
+		k.GetAllValidatorLiquidShares(ctx),
+		k.GetAllValidatorBonds(ctx),
+	)
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/liquidstaking/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// TokenizeShareRecord implements the Query/TokenizeShareRecord gRPC method.
+func (k Keeper) TokenizeShareRecord(c context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, found := k.GetRecord(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordByDenom implements the Query/TokenizeShareRecordByDenom
+// gRPC method.
+func (k Keeper) TokenizeShareRecordByDenom(c context.Context, req *types.QueryTokenizeShareRecordByDenomRequest) (*types.QueryTokenizeShareRecordByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, _, err := types.ParseShareTokenDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, err := k.GetRecordByDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordByDenomResponse{Record: record}, nil
+}
+
+// TokenizeShareRecords implements the Query/TokenizeShareRecords gRPC method.
+func (k Keeper) TokenizeShareRecords(c context.Context, req *types.QueryTokenizeShareRecordsRequest) (*types.QueryTokenizeShareRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var records []types.TokenizeShareRecord
+	pageRes, err := query.Paginate(k.recordsStore(ctx), req.Pagination, func(_, value []byte) error {
+		var record types.TokenizeShareRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// TokenizeShareRecordsOwned implements the Query/TokenizeShareRecordsOwned
+// gRPC method.
+func (k Keeper) TokenizeShareRecordsOwned(c context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: k.GetRecordsByOwner(ctx, owner)}, nil
+}
+
+// TotalLiquidStaked implements the Query/TotalLiquidStaked gRPC method.
+func (k Keeper) TotalLiquidStaked(c context.Context, req *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalLiquidStakedResponse{
+		LiquidStakedTokens: k.TotalLiquidStakedTokens(ctx),
+		BondedTokens:       k.stakingKeeper.TotalBondedTokens(ctx),
+	}, nil
+}
+
+// ValidatorLiquidStaked implements the Query/ValidatorLiquidStaked gRPC
+// method.
+func (k Keeper) ValidatorLiquidStaked(c context.Context, req *types.QueryValidatorLiquidStakedRequest) (*types.QueryValidatorLiquidStakedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddress)
+	}
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr)
+
+	return &types.QueryValidatorLiquidStakedResponse{
+		LiquidShares:        liquidShares,
+		LiquidTokens:        validator.TokensFromShares(liquidShares).TruncateInt(),
+		DelegatorShares:     validator.GetDelegatorShares(),
+		ValidatorBondShares: k.GetValidatorBondShares(ctx, valAddr),
+	}, nil
+}
+
+// ValidatorBonds implements the Query/ValidatorBonds gRPC method.
+func (k Keeper) ValidatorBonds(c context.Context, req *types.QueryValidatorBondsRequest) (*types.QueryValidatorBondsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryValidatorBondsResponse{ValidatorBonds: k.GetValidatorBonds(ctx, valAddr)}, nil
+}
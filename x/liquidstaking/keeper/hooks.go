@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Hooks wraps the Keeper to implement the staking hooks tracking the shares
+// of the validator bonds and of the liquid staking providers.
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks returns the staking hooks of the liquidstaking module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeDelegationCreated records the zero shares of a tracked delegation
+// being created.
+func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if h.k.isTracked(ctx, delAddr, valAddr) {
+		h.k.setPendingShares(ctx, delAddr, valAddr, sdk.ZeroDec())
+	}
+	return nil
+}
+
+// BeforeDelegationSharesModified records the shares of a tracked delegation
+// before they are modified.
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if !h.k.isTracked(ctx, delAddr, valAddr) {
+		return nil
+	}
+
+	delegation, found := h.k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil
+	}
+	h.k.setPendingShares(ctx, delAddr, valAddr, delegation.Shares)
+	return nil
+}
+
+// AfterDelegationModified applies the change of the shares of a tracked
+// delegation.
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	shares, tracked := h.k.popPendingShares(ctx, delAddr, valAddr)
+	if !tracked {
+		return nil
+	}
+
+	delegation, found := h.k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil
+	}
+	return h.k.updateTrackedShares(ctx, delAddr, valAddr, delegation.Shares.Sub(shares))
+}
+
+// BeforeDelegationRemoved removes the shares of a tracked delegation being
+// removed, and deletes its validator bond.
+//
+// The staking keeper of SDK v0.46.0-beta2 ignores the errors of this hook, so
+// that removing a validator bond the validator cannot do without panics,
+// which reverts the transaction.
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	shares, tracked := h.k.popPendingShares(ctx, delAddr, valAddr)
+	if !tracked {
+		return nil
+	}
+
+	if err := h.k.updateTrackedShares(ctx, delAddr, valAddr, shares.Neg()); err != nil {
+		panic(err)
+	}
+	h.k.DeleteValidatorBond(ctx, delAddr, valAddr)
+	return nil
+}
+
+// AfterValidatorCreated implements the staking hooks.
+func (h Hooks) AfterValidatorCreated(sdk.Context, sdk.ValAddress) error { return nil }
+
+// BeforeValidatorModified implements the staking hooks.
+func (h Hooks) BeforeValidatorModified(sdk.Context, sdk.ValAddress) error { return nil }
+
+// AfterValidatorRemoved implements the staking hooks.
+func (h Hooks) AfterValidatorRemoved(sdk.Context, sdk.ConsAddress, sdk.ValAddress) error { return nil }
+
+// AfterValidatorBonded implements the staking hooks.
+func (h Hooks) AfterValidatorBonded(sdk.Context, sdk.ConsAddress, sdk.ValAddress) error { return nil }
+
+// AfterValidatorBeginUnbonding implements the staking hooks.
+func (h Hooks) AfterValidatorBeginUnbonding(sdk.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeValidatorSlashed implements the staking hooks.
+func (h Hooks) BeforeValidatorSlashed(sdk.Context, sdk.ValAddress, sdk.Dec) error { return nil }
@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/liquidstaking/types"
+)
+
+// GetLastRecordID returns the id of the last created tokenize share record.
+func (k Keeper) GetLastRecordID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastRecordID sets the id of the last created tokenize share record.
+func (k Keeper) SetLastRecordID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetRecord returns a tokenize share record by id.
+func (k Keeper) GetRecord(ctx sdk.Context, id uint64) (types.TokenizeShareRecord, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RecordKey(id))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, false
+	}
+
+	var record types.TokenizeShareRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetRecordByDenom returns the tokenize share record of a share token denom.
+func (k Keeper) GetRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
+	validator, id, err := types.ParseShareTokenDenom(denom)
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	record, found := k.GetRecord(ctx, id)
+	if !found || !record.GetValidatorAddr().Equals(validator) {
+		return types.TokenizeShareRecord{}, types.ErrRecordNotFound.Wrapf("share token denom %s", denom)
+	}
+
+	return record, nil
+}
+
+// SetRecord sets a tokenize share record, and indexes it by owner.
+func (k Keeper) SetRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RecordKey(record.Id), k.cdc.MustMarshal(&record))
+	store.Set(types.RecordByOwnerKey(record.GetOwnerAddr(), record.Id), []byte{})
+}
+
+// DeleteRecord deletes a tokenize share record and its owner index entry.
+func (k Keeper) DeleteRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RecordKey(record.Id))
+	store.Delete(types.RecordByOwnerKey(record.GetOwnerAddr(), record.Id))
+}
+
+// IterateRecords iterates over the tokenize share records, in id order, until
+// cb returns true.
+func (k Keeper) IterateRecords(ctx sdk.Context, cb func(record types.TokenizeShareRecord) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RecordKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllRecords returns all the tokenize share records.
+func (k Keeper) GetAllRecords(ctx sdk.Context) []types.TokenizeShareRecord {
+	var records []types.TokenizeShareRecord
+	k.IterateRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// GetRecordsByOwner returns the tokenize share records of an owner.
+func (k Keeper) GetRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) []types.TokenizeShareRecord {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RecordByOwnerPrefix(owner))
+	defer iterator.Close()
+
+	var records []types.TokenizeShareRecord
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(iterator.Key())-8:])
+		record, found := k.GetRecord(ctx, id)
+		if !found {
+			panic("tokenize share record of the owner index not found")
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetValidatorLiquidShares returns the tokenized delegator shares of a
+// validator.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, validator sdk.ValAddress) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.ValidatorLiquidSharesKey(validator))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var shares types.ValidatorLiquidShares
+	k.cdc.MustUnmarshal(bz, &shares)
+	return shares.LiquidShares
+}
+
+// SetValidatorLiquidShares sets the tokenized delegator shares of a
+// validator, deleting them if they are not positive.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, validator sdk.ValAddress, liquidShares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if !liquidShares.IsPositive() {
+		store.Delete(types.ValidatorLiquidSharesKey(validator))
+		return
+	}
+
+	shares := types.ValidatorLiquidShares{
+		ValidatorAddress: validator.String(),
+		LiquidShares:     liquidShares,
+	}
+	store.Set(types.ValidatorLiquidSharesKey(validator), k.cdc.MustMarshal(&shares))
+}
+
+// IterateValidatorLiquidShares iterates over the tokenized delegator shares of
+// the validators, until cb returns true.
+func (k Keeper) IterateValidatorLiquidShares(ctx sdk.Context, cb func(shares types.ValidatorLiquidShares) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ValidatorLiquidSharesKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var shares types.ValidatorLiquidShares
+		k.cdc.MustUnmarshal(iterator.Value(), &shares)
+		if cb(shares) {
+			break
+		}
+	}
+}
+
+// GetAllValidatorLiquidShares returns the tokenized delegator shares of all
+// the validators.
+func (k Keeper) GetAllValidatorLiquidShares(ctx sdk.Context) []types.ValidatorLiquidShares {
+	var all []types.ValidatorLiquidShares
+	k.IterateValidatorLiquidShares(ctx, func(shares types.ValidatorLiquidShares) bool {
+		all = append(all, shares)
+		return false
+	})
+
+	return all
+}
+
+// GetValidatorBond returns the validator bond of a delegation, if it is
+// designated as one.
+func (k Keeper) GetValidatorBond(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (types.ValidatorBond, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ValidatorBondKey(validator, delegator))
+	if bz == nil {
+		return types.ValidatorBond{}, false
+	}
+
+	var bond types.ValidatorBond
+	k.cdc.MustUnmarshal(bz, &bond)
+	return bond, true
+}
+
+// SetValidatorBond sets a validator bond.
+func (k Keeper) SetValidatorBond(ctx sdk.Context, bond types.ValidatorBond) {
+	delegator, err := sdk.AccAddressFromBech32(bond.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	validator, err := sdk.ValAddressFromBech32(bond.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.ValidatorBondKey(validator, delegator), k.cdc.MustMarshal(&bond))
+}
+
+// DeleteValidatorBond deletes the validator bond of a delegation.
+func (k Keeper) DeleteValidatorBond(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.ValidatorBondKey(validator, delegator))
+}
+
+// GetValidatorBonds returns the validator bonds of a validator.
+func (k Keeper) GetValidatorBonds(ctx sdk.Context, validator sdk.ValAddress) []types.ValidatorBond {
+	return k.getValidatorBonds(ctx, types.ValidatorBondsPrefix(validator))
+}
+
+// GetAllValidatorBonds returns the validator bonds of all the validators.
+func (k Keeper) GetAllValidatorBonds(ctx sdk.Context) []types.ValidatorBond {
+	return k.getValidatorBonds(ctx, types.ValidatorBondKeyPrefix)
+}
+
+// GetValidatorBondShares returns the delegator shares of the validator bonds
+// of a validator.
+func (k Keeper) GetValidatorBondShares(ctx sdk.Context, validator sdk.ValAddress) sdk.Dec {
+	shares := sdk.ZeroDec()
+	for _, bond := range k.GetValidatorBonds(ctx, validator) {
+		shares = shares.Add(bond.Shares)
+	}
+
+	return shares
+}
+
+func (k Keeper) getValidatorBonds(ctx sdk.Context, prefix []byte) []types.ValidatorBond {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	var bonds []types.ValidatorBond
+	for ; iterator.Valid(); iterator.Next() {
+		var bond types.ValidatorBond
+		k.cdc.MustUnmarshal(iterator.Value(), &bond)
+		bonds = append(bonds, bond)
+	}
+
+	return bonds
+}
+
+// setPendingShares records the shares of a tracked delegation before it is
+// modified.
+func (k Keeper) setPendingShares(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, shares sdk.Dec) {
+	ctx.KVStore(k.storeKey).Set(types.PendingSharesKey(delegator, validator), k.cdc.MustMarshal(&sdk.DecProto{Dec: shares}))
+}
+
+// popPendingShares returns and deletes the shares of a tracked delegation
+// recorded before it was modified. It returns false if the delegation is not
+// tracked.
+func (k Keeper) popPendingShares(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) (sdk.Dec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.PendingSharesKey(delegator, validator)
+	bz := store.Get(key)
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+	store.Delete(key)
+
+	var shares sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &shares)
+	return shares.Dec, true
+}
+
+// recordsStore returns the store of the tokenize share records, keyed by id.
+func (k Keeper) recordsStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.RecordKeyPrefix)
+}
@@ -0,0 +1,300 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v8/x/liquidstaking/types"
+)
+
+// TokenizeShares moves an amount of bond denom tokens of a delegation to the
+// module account of a new tokenize share record, and mints to the delegator
+// the share tokens of the record, one per delegator share. The owner of the
+// record receives the rewards of the tokenized delegation.
+func (k Keeper) TokenizeShares(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin, owner sdk.AccAddress) (sdk.Coin, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if amount.Denom != bondDenom {
+		return sdk.Coin{}, sdkerrors.ErrInvalidRequest.Wrapf("invalid denom %s, expected %s", amount.Denom, bondDenom)
+	}
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound.Wrap(valAddr.String())
+	}
+	if _, found := k.stakingKeeper.GetDelegation(ctx, delegator, valAddr); !found {
+		return sdk.Coin{}, types.ErrNoDelegation.Wrapf("from %s to %s", delegator, valAddr)
+	}
+	// the validator bond is what the liquid shares are measured against
+	if _, found := k.GetValidatorBond(ctx, delegator, valAddr); found {
+		return sdk.Coin{}, types.ErrValidatorBondNotAllowed.Wrap("validator bond delegations cannot be tokenized")
+	}
+
+	// the vesting of the delegated tokens would not be tracked once they
+	// are tokenized
+	if acc, ok := k.accountKeeper.GetAccount(ctx, delegator).(vestexported.VestingAccount); ok {
+		if acc.GetDelegatedFree().AmountOf(bondDenom).LT(amount.Amount) {
+			return sdk.Coin{}, types.ErrTokenizeVestingDelegation.Wrapf("only %s of the delegations are free", acc.GetDelegatedFree())
+		}
+	}
+
+	shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, delegator, valAddr, amount.Amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	id := k.GetLastRecordID(ctx) + 1
+	record := types.NewTokenizeShareRecord(id, owner, valAddr)
+	recordAddr := record.GetModuleAddress()
+	if k.accountKeeper.GetAccount(ctx, recordAddr) == nil {
+		// the record delegation is held by a module account, so that no one
+		// can sign for it
+		acc := authtypes.NewEmptyModuleAccount(types.RecordModuleAccountName(id))
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccount(ctx, acc))
+	}
+
+	// the tokens are moved from the delegator to the record through the bank
+	// keeper delegation functions, which track the delegated free and
+	// vesting tokens of vesting accounts, so that the locked tokens of the
+	// delegator cannot be sent to the record
+	tokens, err := k.stakingKeeper.Unbond(ctx, delegator, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound.Wrap(valAddr.String())
+	}
+	pool := stakingtypes.NotBondedPoolName
+	if validator.IsBonded() {
+		pool = stakingtypes.BondedPoolName
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, tokens))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, pool, delegator, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, delegator, recordAddr, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+	recordShares, err := k.stakingKeeper.Delegate(ctx, recordAddr, tokens, stakingtypes.Unbonded, validator, true)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareTokens := sdk.NewCoin(record.GetShareTokenDenom(), recordShares.TruncateInt())
+	if !shareTokens.IsPositive() {
+		return sdk.Coin{}, types.ErrTokenizeAmountTooSmall.Wrap(amount.String())
+	}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegator, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.SetRecord(ctx, record)
+	k.SetLastRecordID(ctx, id)
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(recordShares))
+	if err := k.checkLiquidStakingCaps(ctx, valAddr); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.checkValidatorBond(ctx, valAddr, sdk.ZeroDec()); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTokenizeShares,
+		sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyShareOwner, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(bondDenom, tokens).String()),
+		sdk.NewAttribute(types.AttributeKeyShares, shareTokens.String()),
+	))
+
+	return shareTokens, nil
+}
+
+// RedeemTokensForShares burns share tokens and moves the delegator shares
+// they represent from the module account of their record to the holder.
+// The record is deleted, and its remaining rewards sent to its owner, once
+// all its share tokens are redeemed.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delegator sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	record, err := k.GetRecordByDenom(ctx, amount.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	valAddr := record.GetValidatorAddr()
+	recordAddr := record.GetModuleAddress()
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, recordAddr, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation.Wrapf("from tokenize share record %d to %s", record.Id, valAddr)
+	}
+
+	// the share tokens are truncated shares, so that the last ones redeem
+	// the remainder of the record delegation
+	shares := amount.Amount.ToDec()
+	last := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount.Equal(amount.Amount)
+	if last {
+		shares = delegation.Shares
+	}
+	if shares.GT(delegation.Shares) {
+		return sdk.Coin{}, types.ErrRedeemAmountExceedsRecordShares.Wrapf("%s > %s", shares, delegation.Shares)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegator, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokens, err := k.stakingKeeper.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	// the shares are no longer liquid before they are delegated to the
+	// holder, which may be a liquid staking provider
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares))
+	if tokens.IsPositive() {
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return sdk.Coin{}, types.ErrNoValidatorFound.Wrap(valAddr.String())
+		}
+		if _, err := k.stakingKeeper.Delegate(ctx, delegator, tokens, validator.GetStatus(), validator, false); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if last {
+		// the rewards withdrawn on unbonding are left on the module account
+		// of the record
+		if _, err := k.sendRecordBalances(ctx, record); err != nil {
+			return sdk.Coin{}, err
+		}
+		k.DeleteRecord(ctx, record)
+	}
+
+	bonded := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), tokens)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRedeemShares,
+		sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(record.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyShares, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, bonded.String()),
+	))
+
+	return bonded, nil
+}
+
+// TransferRecord transfers the ownership of a tokenize share record.
+func (k Keeper) TransferRecord(ctx sdk.Context, id uint64, sender, newOwner sdk.AccAddress) error {
+	record, found := k.GetRecord(ctx, id)
+	if !found {
+		return types.ErrRecordNotFound.Wrapf("id %d", id)
+	}
+	if !record.GetOwnerAddr().Equals(sender) {
+		return types.ErrNotRecordOwner.Wrapf("record %d is owned by %s", id, record.Owner)
+	}
+
+	k.DeleteRecord(ctx, record)
+	record.Owner = newOwner.String()
+	k.SetRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferTokenizeShareRecord,
+		sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+	))
+
+	return nil
+}
+
+// WithdrawRecordReward withdraws the rewards of the delegation of a tokenize
+// share record, and sends them to its owner along with the rewards already
+// withdrawn to the module account of the record.
+func (k Keeper) WithdrawRecordReward(ctx sdk.Context, owner sdk.AccAddress, id uint64) (sdk.Coins, error) {
+	record, found := k.GetRecord(ctx, id)
+	if !found {
+		return nil, types.ErrRecordNotFound.Wrapf("id %d", id)
+	}
+	if !record.GetOwnerAddr().Equals(owner) {
+		return nil, types.ErrNotRecordOwner.Wrapf("record %d is owned by %s", id, record.Owner)
+	}
+
+	if _, found := k.stakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), record.GetValidatorAddr()); found {
+		if _, err := k.distrKeeper.WithdrawDelegationRewards(ctx, record.GetModuleAddress(), record.GetValidatorAddr()); err != nil {
+			return nil, err
+		}
+	}
+	rewards, err := k.sendRecordBalances(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeWithdrawTokenizeShareRecordReward,
+		sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyShareOwner, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, rewards.String()),
+	))
+
+	return rewards, nil
+}
+
+// TotalLiquidStakedTokens returns the amount of bond denom tokens the
+// liquid delegator shares of all the validators are worth.
+func (k Keeper) TotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	total := sdk.ZeroInt()
+	k.IterateValidatorLiquidShares(ctx, func(shares types.ValidatorLiquidShares) bool {
+		valAddr, err := sdk.ValAddressFromBech32(shares.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		if validator, found := k.stakingKeeper.GetValidator(ctx, valAddr); found {
+			total = total.Add(validator.TokensFromShares(shares.LiquidShares).TruncateInt())
+		}
+		return false
+	})
+
+	return total
+}
+
+// checkLiquidStakingCaps returns an error if the liquid staked tokens exceed
+// the global liquid staking cap, or the liquid shares of a validator exceed
+// the validator liquid staking cap.
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	params := k.GetParams(ctx)
+
+	liquidTokens := k.TotalLiquidStakedTokens(ctx)
+	bondedTokens := k.stakingKeeper.TotalBondedTokens(ctx)
+	if liquidTokens.ToDec().GT(params.GlobalLiquidStakingCap.MulInt(bondedTokens)) {
+		return types.ErrGlobalLiquidStakingCapExceeded.Wrapf("%s liquid staked tokens for %s bonded tokens", liquidTokens, bondedTokens)
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound.Wrap(valAddr.String())
+	}
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr)
+	if liquidShares.GT(params.ValidatorLiquidStakingCap.Mul(validator.GetDelegatorShares())) {
+		return types.ErrValidatorLiquidStakingCapExceeded.Wrapf("%s liquid shares for %s delegator shares", liquidShares, validator.GetDelegatorShares())
+	}
+
+	return nil
+}
+
+// sendRecordBalances sends the balances of the module account of a tokenize
+// share record to its owner.
+func (k Keeper) sendRecordBalances(ctx sdk.Context, record types.TokenizeShareRecord) (sdk.Coins, error) {
+	balances := k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress())
+	if balances.IsZero() {
+		return balances, nil
+	}
+
+	return balances, k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), record.GetOwnerAddr(), balances)
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	gaiahelpers "github.com/cosmos/gaia/v8/app/helpers"
+	"github.com/cosmos/gaia/v8/x/liquidstaking/types"
+)
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	f := gaiahelpers.SetupStaking(t, sdk.NewInt(1_000_000))
+	k, ctx := f.App.LiquidStakingKeeper, f.Ctx
+	bondedPool := f.App.StakingKeeper.GetBondedPool(ctx).GetAddress()
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	carol := sdk.AccAddress("carol_______________")
+	f.Delegate(t, alice, sdk.NewInt(1_000_000))
+
+	// 3 000 000 tokens are bonded, 2 000 000 to the validator, which does
+	// not need a validator bond
+	k.SetParams(ctx, types.NewParams(sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(50, 2), sdk.NewDec(-1)))
+	bondedBefore := f.App.BankKeeper.GetBalance(ctx, bondedPool, f.BondDenom)
+
+	shareTokens, err := k.TokenizeShares(ctx, alice, f.Validator, sdk.NewCoin(f.BondDenom, sdk.NewInt(400_000)), carol)
+	require.NoError(t, err)
+	denom := types.ShareTokenDenom(f.Validator.String(), 1)
+	require.Equal(t, sdk.NewCoin(denom, sdk.NewInt(400_000)), shareTokens)
+	require.Equal(t, shareTokens, f.App.BankKeeper.GetBalance(ctx, alice, denom))
+	require.Equal(t, sdk.NewInt(600_000), f.Delegation(alice))
+	require.Equal(t, sdk.NewInt(400_000), f.Delegation(types.RecordModuleAddress(1)))
+	require.Equal(t, sdk.NewDec(400_000), k.GetValidatorLiquidShares(ctx, f.Validator))
+	require.Equal(t, sdk.NewInt(400_000), k.TotalLiquidStakedTokens(ctx))
+	// the tokens stay bonded
+	require.Equal(t, bondedBefore, f.App.BankKeeper.GetBalance(ctx, bondedPool, f.BondDenom))
+
+	record, err := k.GetRecordByDenom(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, carol.String(), record.Owner)
+	require.Equal(t, []types.TokenizeShareRecord{record}, k.GetRecordsByOwner(ctx, carol))
+
+	// 800 000 liquid staked tokens exceed a quarter of the bonded tokens; the
+	// failed messages are run in a cached context, as they would be in a
+	// transaction
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = k.TokenizeShares(cacheCtx, alice, f.Validator, sdk.NewCoin(f.BondDenom, sdk.NewInt(400_000)), alice)
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	// 700 000 liquid shares exceed 30% of the delegator shares
+	k.SetParams(ctx, types.NewParams(sdk.OneDec(), sdk.NewDecWithPrec(30, 2), sdk.NewDec(-1)))
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = k.TokenizeShares(cacheCtx, alice, f.Validator, sdk.NewCoin(f.BondDenom, sdk.NewInt(300_000)), alice)
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// the share tokens are transferable, and redeemed by their holder
+	require.NoError(t, f.App.BankKeeper.SendCoins(ctx, alice, bob, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100_000)))))
+	bonded, err := k.RedeemTokensForShares(ctx, bob, sdk.NewCoin(denom, sdk.NewInt(100_000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(f.BondDenom, sdk.NewInt(100_000)), bonded)
+	require.Equal(t, sdk.NewInt(100_000), f.Delegation(bob))
+	require.Equal(t, sdk.NewInt(300_000), f.Delegation(types.RecordModuleAddress(1)))
+	require.Equal(t, sdk.NewDec(300_000), k.GetValidatorLiquidShares(ctx, f.Validator))
+	require.True(t, f.App.BankKeeper.GetBalance(ctx, bob, denom).IsZero())
+
+	// the owner of the record receives the rewards of the delegation, which
+	// accrue from the next block
+	f.Ctx = f.Ctx.WithBlockHeight(2)
+	ctx = f.Ctx
+	f.AllocateRewards(t, sdk.NewInt(100_000))
+	_, err = k.WithdrawRecordReward(ctx, alice, 1)
+	require.ErrorIs(t, err, types.ErrNotRecordOwner)
+	require.NoError(t, k.TransferRecord(ctx, 1, carol, bob))
+	require.Empty(t, k.GetRecordsByOwner(ctx, carol))
+	rewards, err := k.WithdrawRecordReward(ctx, bob, 1)
+	require.NoError(t, err)
+	require.True(t, rewards.AmountOf(f.BondDenom).IsPositive())
+
+	// redeeming the last share tokens deletes the record, and sends its
+	// remaining rewards to its owner
+	f.Ctx = f.Ctx.WithBlockHeight(3)
+	ctx = f.Ctx
+	f.AllocateRewards(t, sdk.NewInt(100_000))
+	bobBalance := f.App.BankKeeper.GetBalance(ctx, bob, f.BondDenom)
+	_, err = k.RedeemTokensForShares(ctx, alice, sdk.NewCoin(denom, sdk.NewInt(300_000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(900_000), f.Delegation(alice))
+	require.True(t, f.App.BankKeeper.GetBalance(ctx, bob, f.BondDenom).IsGTE(bobBalance.AddAmount(sdk.OneInt())))
+	require.True(t, f.App.BankKeeper.GetAllBalances(ctx, types.RecordModuleAddress(1)).IsZero())
+	_, found := k.GetRecord(ctx, 1)
+	require.False(t, found)
+	require.True(t, k.GetValidatorLiquidShares(ctx, f.Validator).IsZero())
+	require.True(t, f.App.BankKeeper.GetSupply(ctx, denom).IsZero())
+
+	_, err = k.RedeemTokensForShares(ctx, alice, sdk.NewCoin(denom, sdk.NewInt(1)))
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+}
+
+func TestTokenizeSharesErrors(t *testing.T) {
+	f := gaiahelpers.SetupStaking(t, sdk.NewInt(1_000_000))
+	k, ctx := f.App.LiquidStakingKeeper, f.Ctx
+	k.SetParams(ctx, types.NewParams(sdk.OneDec(), sdk.OneDec(), sdk.NewDec(-1)))
+
+	alice := sdk.AccAddress("alice_______________")
+	f.Delegate(t, alice, sdk.NewInt(1_000_000))
+
+	_, err := k.TokenizeShares(ctx, alice, f.Validator, sdk.NewCoin("other", sdk.NewInt(1)), alice)
+	require.Error(t, err)
+	_, err = k.TokenizeShares(ctx, alice, f.Validator, sdk.NewCoin(f.BondDenom, sdk.NewInt(2_000_000)), alice)
+	require.Error(t, err)
+	_, err = k.TokenizeShares(ctx, sdk.AccAddress("bob_________________"), f.Validator, sdk.NewCoin(f.BondDenom, sdk.NewInt(1)), alice)
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+	_, err = k.TokenizeShares(ctx, alice, sdk.ValAddress("unknown_____________"), sdk.NewCoin(f.BondDenom, sdk.NewInt(1)), alice)
+	require.ErrorIs(t, err, types.ErrNoValidatorFound)
+
+	// share tokens of another validator do not redeem a record
+	_, err = k.TokenizeShares(ctx, alice, f.Validator, sdk.NewCoin(f.BondDenom, sdk.NewInt(1_000)), alice)
+	require.NoError(t, err)
+	_, err = k.GetRecordByDenom(ctx, types.ShareTokenDenom(sdk.ValAddress("unknown_____________").String(), 1))
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+}
+
+func TestTokenizeVestingDelegation(t *testing.T) {
+	f := gaiahelpers.SetupStaking(t, sdk.NewInt(1_000_000))
+	k, ctx := f.App.LiquidStakingKeeper, f.Ctx
+	k.SetParams(ctx, types.NewParams(sdk.OneDec(), sdk.OneDec(), sdk.NewDec(-1)))
+
+	// 900 000 of the 1 000 000 delegated tokens are vesting
+	vester := sdk.AccAddress("vester______________")
+	f.Fund(t, vester, sdk.NewInt(1_000_000))
+	baseAcc, ok := f.App.AccountKeeper.GetAccount(ctx, vester).(*authtypes.BaseAccount)
+	require.True(t, ok)
+	vestingAcc := vestingtypes.NewDelayedVestingAccount(baseAcc, sdk.NewCoins(sdk.NewCoin(f.BondDenom, sdk.NewInt(900_000))), ctx.BlockTime().Add(365*24*time.Hour).Unix())
+	f.App.AccountKeeper.SetAccount(ctx, vestingAcc)
+	validator, found := f.App.StakingKeeper.GetValidator(ctx, f.Validator)
+	require.True(t, found)
+	_, err := f.App.StakingKeeper.Delegate(ctx, vester, sdk.NewInt(1_000_000), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// the free part of the delegation is tokenized, and is no longer
+	// tracked as delegated free tokens
+	_, err = k.TokenizeShares(ctx, vester, f.Validator, sdk.NewCoin(f.BondDenom, sdk.NewInt(100_000)), vester)
+	require.NoError(t, err)
+	acc, ok := f.App.AccountKeeper.GetAccount(ctx, vester).(*vestingtypes.DelayedVestingAccount)
+	require.True(t, ok)
+	require.True(t, acc.GetDelegatedFree().IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(f.BondDenom, sdk.NewInt(900_000))), acc.GetDelegatedVesting())
+	require.Equal(t, sdk.NewInt(900_000), f.Delegation(vester))
+	require.Equal(t, sdk.NewInt(100_000), f.Delegation(types.RecordModuleAddress(1)))
+
+	// the rest of the delegation is vesting, and cannot be tokenized
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = k.TokenizeShares(cacheCtx, vester, f.Validator, sdk.NewCoin(f.BondDenom, sdk.NewInt(100_000)), vester)
+	require.ErrorIs(t, err, types.ErrTokenizeVestingDelegation)
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+
+	"github.com/cosmos/gaia/v8/x/liquidstaking/types"
+)
+
+// ValidatorBond designates a delegation as a validator bond, so that its
+// shares allow the validator to receive liquid shares.
+func (k Keeper) ValidatorBond(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return types.ErrNoValidatorFound.Wrap(valAddr.String())
+	}
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, delegator, valAddr)
+	if !found {
+		return types.ErrNoDelegation.Wrapf("from %s to %s", delegator, valAddr)
+	}
+	if _, found := k.GetValidatorBond(ctx, delegator, valAddr); found {
+		return types.ErrValidatorBondExists.Wrapf("from %s to %s", delegator, valAddr)
+	}
+	// the delegations of the liquid staking providers are liquid shares
+	if k.IsLiquidStakingProvider(ctx, delegator) {
+		return types.ErrValidatorBondNotAllowed.Wrapf("%s is a liquid staking provider", delegator)
+	}
+
+	k.SetValidatorBond(ctx, types.NewValidatorBond(delegator, valAddr, delegation.Shares))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeValidatorBond,
+		sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, delegation.Shares.String()),
+	))
+
+	return nil
+}
+
+// IsLiquidStakingProvider returns whether the delegations of an account are
+// liquid shares. They are for the interchain accounts, through which the
+// liquid staking providers of other chains delegate.
+func (k Keeper) IsLiquidStakingProvider(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(*icatypes.InterchainAccount)
+	return ok
+}
+
+// isTracked returns whether the shares of a delegation are tracked, as a
+// validator bond or as liquid shares. No delegation is tracked while the
+// staking genesis is initialized, before the liquidstaking genesis which
+// already holds the tracked shares.
+func (k Keeper) isTracked(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	if !k.paramSpace.Has(ctx, types.KeyValidatorBondFactor) {
+		return false
+	}
+	if _, found := k.GetValidatorBond(ctx, delegator, valAddr); found {
+		return true
+	}
+
+	return k.IsLiquidStakingProvider(ctx, delegator)
+}
+
+// updateTrackedShares applies a change of the shares of a tracked delegation
+// to the validator bond or the liquid shares of the validator. It returns an
+// error if the liquid shares increase beyond the liquid staking caps or the
+// validator bond requirement, or if the validator bond decreases below it.
+func (k Keeper) updateTrackedShares(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, delta sdk.Dec) error {
+	bond, isBond := k.GetValidatorBond(ctx, delegator, valAddr)
+	if isBond {
+		// the decrease is checked before it is applied, so that nothing is
+		// written when it is rejected
+		if delta.IsNegative() {
+			if err := k.checkValidatorBond(ctx, valAddr, delta); err != nil {
+				return err
+			}
+		}
+		bond.Shares = bond.Shares.Add(delta)
+		k.SetValidatorBond(ctx, bond)
+		return nil
+	}
+
+	if k.IsLiquidStakingProvider(ctx, delegator) {
+		k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(delta))
+		if delta.IsPositive() {
+			if err := k.checkLiquidStakingCaps(ctx, valAddr); err != nil {
+				return err
+			}
+			return k.checkValidatorBond(ctx, valAddr, sdk.ZeroDec())
+		}
+	}
+
+	return nil
+}
+
+// checkValidatorBond returns an error if the liquid shares of a validator
+// exceed its validator bond shares, changed by a delta, multiplied by the
+// validator bond factor.
+func (k Keeper) checkValidatorBond(ctx sdk.Context, valAddr sdk.ValAddress, bondDelta sdk.Dec) error {
+	params := k.GetParams(ctx)
+	if !params.ValidatorBondRequired() {
+		return nil
+	}
+
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr)
+	bondShares := k.GetValidatorBondShares(ctx, valAddr).Add(bondDelta)
+	if liquidShares.GT(bondShares.Mul(params.ValidatorBondFactor)) {
+		return types.ErrInsufficientValidatorBond.Wrapf(
+			"%s liquid shares for %s validator bond shares with a factor of %s", liquidShares, bondShares, params.ValidatorBondFactor,
+		)
+	}
+
+	return nil
+}
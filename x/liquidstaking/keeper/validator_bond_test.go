@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	"github.com/stretchr/testify/require"
+
+	gaiahelpers "github.com/cosmos/gaia/v8/app/helpers"
+	"github.com/cosmos/gaia/v8/x/liquidstaking/types"
+)
+
+func undelegate(f gaiahelpers.StakingFixture, delegator sdk.AccAddress, amount sdk.Int) error {
+	shares, err := f.App.StakingKeeper.ValidateUnbondAmount(f.Ctx, delegator, f.Validator, amount)
+	if err != nil {
+		return err
+	}
+	_, err = f.App.StakingKeeper.Undelegate(f.Ctx, delegator, f.Validator, shares)
+	return err
+}
+
+// requirePanicsWithErrorIs asserts that a function panics with an error
+// wrapping a target error.
+func requirePanicsWithErrorIs(t *testing.T, target error, f func()) {
+	t.Helper()
+
+	defer func() {
+		err, ok := recover().(error)
+		require.True(t, ok, "the function did not panic with an error")
+		require.ErrorIs(t, err, target)
+	}()
+	f()
+}
+
+func TestValidatorBond(t *testing.T) {
+	f := gaiahelpers.SetupStaking(t, sdk.NewInt(1_000_000))
+	k, ctx := f.App.LiquidStakingKeeper, f.Ctx
+	// the liquid shares are limited to twice the validator bond shares
+	k.SetParams(ctx, types.NewParams(sdk.OneDec(), sdk.OneDec(), sdk.NewDec(2)))
+
+	operator := sdk.AccAddress(f.Validator)
+	alice := sdk.AccAddress("alice_______________")
+	f.Delegate(t, alice, sdk.NewInt(1_000_000))
+
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := k.TokenizeShares(cacheCtx, alice, f.Validator, sdk.NewCoin(f.BondDenom, sdk.NewInt(100_000)), alice)
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+
+	require.ErrorIs(t, k.ValidatorBond(ctx, alice, sdk.ValAddress("unknown_____________")), types.ErrNoValidatorFound)
+	require.ErrorIs(t, k.ValidatorBond(ctx, sdk.AccAddress("bob_________________"), f.Validator), types.ErrNoDelegation)
+	require.NoError(t, k.ValidatorBond(ctx, operator, f.Validator))
+	require.ErrorIs(t, k.ValidatorBond(ctx, operator, f.Validator), types.ErrValidatorBondExists)
+	require.Equal(t, sdk.NewDec(1_000_000), k.GetValidatorBondShares(ctx, f.Validator))
+
+	// a validator bond cannot be tokenized
+	_, err = k.TokenizeShares(ctx, operator, f.Validator, sdk.NewCoin(f.BondDenom, sdk.NewInt(100_000)), operator)
+	require.ErrorIs(t, err, types.ErrValidatorBondNotAllowed)
+	_, err = k.TokenizeShares(ctx, alice, f.Validator, sdk.NewCoin(f.BondDenom, sdk.NewInt(800_000)), alice)
+	require.NoError(t, err)
+
+	// the validator bond follows the delegation, and cannot decrease below
+	// what the 800 000 liquid shares require
+	f.Delegate(t, operator, sdk.NewInt(100_000))
+	require.Equal(t, sdk.NewDec(1_100_000), k.GetValidatorBondShares(ctx, f.Validator))
+	cacheCtx, _ = ctx.CacheContext()
+	f.Ctx = cacheCtx
+	require.ErrorIs(t, undelegate(f, operator, sdk.NewInt(800_000)), types.ErrInsufficientValidatorBond)
+	f.Ctx = ctx
+	require.NoError(t, undelegate(f, operator, sdk.NewInt(100_000)))
+	require.Equal(t, sdk.NewDec(1_000_000), k.GetValidatorBondShares(ctx, f.Validator))
+
+	// the delegations of the interchain accounts of the liquid staking
+	// providers are liquid shares
+	dave := sdk.AccAddress("dave________________")
+	f.App.AccountKeeper.SetAccount(ctx, icatypes.NewInterchainAccount(
+		f.App.AccountKeeper.NewAccount(ctx, authtypes.NewBaseAccountWithAddress(dave)).(*authtypes.BaseAccount),
+		"controller",
+	))
+	require.ErrorIs(t, k.ValidatorBond(ctx, dave, f.Validator), types.ErrNoDelegation)
+	f.Delegate(t, dave, sdk.NewInt(1_000_000))
+	require.Equal(t, sdk.NewDec(1_800_000), k.GetValidatorLiquidShares(ctx, f.Validator))
+	require.ErrorIs(t, k.ValidatorBond(ctx, dave, f.Validator), types.ErrValidatorBondNotAllowed)
+
+	f.Fund(t, dave, sdk.NewInt(300_000))
+	validator, found := f.App.StakingKeeper.GetValidator(ctx, f.Validator)
+	require.True(t, found)
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = f.App.StakingKeeper.Delegate(cacheCtx, dave, sdk.NewInt(300_000), stakingtypes.Unbonded, validator, true)
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+
+	require.NoError(t, undelegate(f, dave, sdk.NewInt(1_000_000)))
+	require.Equal(t, sdk.NewDec(800_000), k.GetValidatorLiquidShares(ctx, f.Validator))
+
+	// removing a validator bond the liquid shares require reverts, through a
+	// panic as the staking keeper ignores the errors of the hook, and leaves
+	// the delegation and the validator bond as they are
+	var otherValidator sdk.ValAddress
+	for _, validator := range f.App.StakingKeeper.GetAllValidators(ctx) {
+		if !validator.GetOperator().Equals(f.Validator) {
+			otherValidator = validator.GetOperator()
+		}
+	}
+	delegation, found := f.App.StakingKeeper.GetDelegation(ctx, operator, f.Validator)
+	require.True(t, found)
+	bond, found := k.GetValidatorBond(ctx, operator, f.Validator)
+	require.True(t, found)
+	for _, remove := range []func(sdk.Context) error{
+		func(ctx sdk.Context) error {
+			_, err := f.App.StakingKeeper.Undelegate(ctx, operator, f.Validator, delegation.Shares)
+			return err
+		},
+		func(ctx sdk.Context) error {
+			_, err := f.App.StakingKeeper.BeginRedelegation(ctx, operator, f.Validator, otherValidator, delegation.Shares)
+			return err
+		},
+	} {
+		cacheCtx, _ = ctx.CacheContext()
+		requirePanicsWithErrorIs(t, types.ErrInsufficientValidatorBond, func() { _ = remove(cacheCtx) })
+		remaining, found := f.App.StakingKeeper.GetDelegation(cacheCtx, operator, f.Validator)
+		require.True(t, found)
+		require.Equal(t, delegation.Shares, remaining.Shares)
+		remainingBond, found := k.GetValidatorBond(cacheCtx, operator, f.Validator)
+		require.True(t, found)
+		require.Equal(t, bond, remainingBond)
+	}
+
+	// a validator bond is removed along with its delegation
+	_, err = k.RedeemTokensForShares(ctx, alice, sdk.NewCoin(types.ShareTokenDenom(f.Validator.String(), 1), sdk.NewInt(800_000)))
+	require.NoError(t, err)
+	require.NoError(t, undelegate(f, operator, sdk.NewInt(1_000_000)))
+	_, found = k.GetValidatorBond(ctx, operator, f.Validator)
+	require.False(t, found)
+	require.True(t, k.GetValidatorBondShares(ctx, f.Validator).IsZero())
+
+	genState := k.ExportGenesis(ctx)
+	require.Empty(t, genState.ValidatorBonds)
+	require.NoError(t, genState.Validate())
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/liquidstaking errors
+var (
+	ErrNoValidatorFound                  = sdkerrors.Register(ModuleName, 2, "validator does not exist")
+	ErrNoDelegation                      = sdkerrors.Register(ModuleName, 3, "delegation does not exist")
+	ErrRecordNotFound                    = sdkerrors.Register(ModuleName, 4, "tokenize share record not found")
+	ErrNotRecordOwner                    = sdkerrors.Register(ModuleName, 5, "not the owner of the tokenize share record")
+	ErrInvalidShareDenom                 = sdkerrors.Register(ModuleName, 6, "invalid share token denom")
+	ErrTokenizeAmountTooSmall            = sdkerrors.Register(ModuleName, 7, "amount too small to tokenize")
+	ErrTokenizeVestingDelegation         = sdkerrors.Register(ModuleName, 8, "vesting delegations cannot be tokenized")
+	ErrGlobalLiquidStakingCapExceeded    = sdkerrors.Register(ModuleName, 9, "global liquid staking cap exceeded")
+	ErrValidatorLiquidStakingCapExceeded = sdkerrors.Register(ModuleName, 10, "validator liquid staking cap exceeded")
+	ErrRedeemAmountExceedsRecordShares   = sdkerrors.Register(ModuleName, 11, "redeemed amount exceeds the shares of the tokenize share record")
+	ErrInsufficientValidatorBond         = sdkerrors.Register(ModuleName, 12, "insufficient validator bond shares")
+	ErrValidatorBondNotAllowed           = sdkerrors.Register(ModuleName, 13, "validator bond not allowed")
+	ErrValidatorBondExists               = sdkerrors.Register(ModuleName, 14, "delegation is already a validator bond")
+)
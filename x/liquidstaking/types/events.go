@@ -0,0 +1,19 @@
+package types
+
+// liquidstaking module event types and attributes
+const (
+	EventTypeTokenizeShares                    = "tokenize_shares"
+	EventTypeRedeemShares                      = "redeem_tokens_for_shares"
+	EventTypeTransferTokenizeShareRecord       = "transfer_tokenize_share_record"
+	EventTypeWithdrawTokenizeShareRecordReward = "withdraw_tokenize_share_record_reward"
+	EventTypeValidatorBond                     = "validator_bond"
+
+	AttributeKeyDelegator  = "delegator"
+	AttributeKeyValidator  = "validator"
+	AttributeKeyShareOwner = "share_owner"
+	AttributeKeyRecordID   = "share_record_id"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyShares     = "shares"
+	AttributeKeySender     = "sender"
+	AttributeKeyNewOwner   = "new_owner"
+)
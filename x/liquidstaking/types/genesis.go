@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState instance.
+func NewGenesisState(
+	params Params,
+	records []TokenizeShareRecord,
+	lastRecordID uint64,
+	validatorLiquidShares []ValidatorLiquidShares,
+	validatorBonds []ValidatorBond,
+) *GenesisState {
+	return &GenesisState{
+		Params:                params,
+		Records:               records,
+		LastRecordId:          lastRecordID,
+		ValidatorLiquidShares: validatorLiquidShares,
+		ValidatorBonds:        validatorBonds,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the liquidstaking
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, 0, nil, nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	ids := make(map[uint64]bool, len(gs.Records))
+	for _, record := range gs.Records {
+		if record.Id == 0 || record.Id > gs.LastRecordId {
+			return fmt.Errorf("invalid id of tokenize share record %d, last id is %d", record.Id, gs.LastRecordId)
+		}
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record %d", record.Id)
+		}
+		ids[record.Id] = true
+
+		if _, err := sdk.AccAddressFromBech32(record.Owner); err != nil {
+			return fmt.Errorf("invalid owner address of tokenize share record %d: %w", record.Id, err)
+		}
+		if _, err := sdk.ValAddressFromBech32(record.Validator); err != nil {
+			return fmt.Errorf("invalid validator address of tokenize share record %d: %w", record.Id, err)
+		}
+		if record.ModuleAccount != RecordModuleAddress(record.Id).String() {
+			return fmt.Errorf("invalid module account of tokenize share record %d", record.Id)
+		}
+	}
+
+	validators := make(map[string]bool, len(gs.ValidatorLiquidShares))
+	for _, shares := range gs.ValidatorLiquidShares {
+		if _, err := sdk.ValAddressFromBech32(shares.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address %s: %w", shares.ValidatorAddress, err)
+		}
+		if validators[shares.ValidatorAddress] {
+			return fmt.Errorf("duplicate liquid shares of validator %s", shares.ValidatorAddress)
+		}
+		validators[shares.ValidatorAddress] = true
+
+		if shares.LiquidShares.IsNil() || shares.LiquidShares.IsNegative() {
+			return fmt.Errorf("negative liquid shares of validator %s", shares.ValidatorAddress)
+		}
+	}
+
+	bonds := make(map[string]bool, len(gs.ValidatorBonds))
+	for _, bond := range gs.ValidatorBonds {
+		if _, err := sdk.AccAddressFromBech32(bond.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid delegator address of validator bond %s: %w", bond.DelegatorAddress, err)
+		}
+		if _, err := sdk.ValAddressFromBech32(bond.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address of validator bond %s: %w", bond.ValidatorAddress, err)
+		}
+		key := bond.DelegatorAddress + "/" + bond.ValidatorAddress
+		if bonds[key] {
+			return fmt.Errorf("duplicate validator bond of delegator %s to %s", bond.DelegatorAddress, bond.ValidatorAddress)
+		}
+		bonds[key] = true
+
+		if bond.Shares.IsNil() || bond.Shares.IsNegative() {
+			return fmt.Errorf("negative shares of validator bond of delegator %s to %s", bond.DelegatorAddress, bond.ValidatorAddress)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,559 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/liquidstaking/v1beta1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the liquidstaking module's genesis state.
+type GenesisState struct {
+	// params are the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// records are the tokenize share records.
+	Records []TokenizeShareRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+	// last_record_id is the id of the last created record.
+	LastRecordId uint64 `protobuf:"varint,3,opt,name=last_record_id,json=lastRecordId,proto3" json:"last_record_id,omitempty"`
+	// validator_liquid_shares are the liquid delegator shares of the
+	// validators.
+	ValidatorLiquidShares []ValidatorLiquidShares `protobuf:"bytes,4,rep,name=validator_liquid_shares,json=validatorLiquidShares,proto3" json:"validator_liquid_shares"`
+	// validator_bonds are the delegations designated as validator bonds.
+	ValidatorBonds []ValidatorBond `protobuf:"bytes,5,rep,name=validator_bonds,json=validatorBonds,proto3" json:"validator_bonds"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f850cf07884269d5, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastRecordId() uint64 {
+	if m != nil {
+		return m.LastRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetValidatorLiquidShares() []ValidatorLiquidShares {
+	if m != nil {
+		return m.ValidatorLiquidShares
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorBonds() []ValidatorBond {
+	if m != nil {
+		return m.ValidatorBonds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "gaia.liquidstaking.v1beta1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("gaia/liquidstaking/v1beta1/genesis.proto", fileDescriptor_f850cf07884269d5)
+}
+
+var fileDescriptor_f850cf07884269d5 = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcf, 0x4a, 0xf3, 0x40,
+	0x10, 0x4f, 0xbe, 0xf6, 0xab, 0xb0, 0x2d, 0x15, 0x82, 0x62, 0xe8, 0x21, 0x96, 0xe2, 0x21, 0x5e,
+	0x76, 0x69, 0xbd, 0x78, 0x94, 0x5e, 0xa4, 0x20, 0x28, 0xad, 0x88, 0x78, 0x09, 0x9b, 0x66, 0x49,
+	0x97, 0xb6, 0x99, 0x9a, 0xd9, 0x06, 0xf5, 0x29, 0xc4, 0xa7, 0xea, 0xb1, 0x47, 0x4f, 0x22, 0xed,
+	0x8b, 0x48, 0x36, 0x0d, 0x5a, 0xd1, 0xe0, 0x6d, 0x77, 0xe6, 0xf7, 0x6f, 0xf8, 0x11, 0x37, 0xe4,
+	0x92, 0xb3, 0x89, 0xbc, 0x9f, 0xcb, 0x00, 0x15, 0x1f, 0xcb, 0x28, 0x64, 0x49, 0xdb, 0x17, 0x8a,
+	0xb7, 0x59, 0x28, 0x22, 0x81, 0x12, 0xe9, 0x2c, 0x06, 0x05, 0x56, 0x23, 0x45, 0xd2, 0x2d, 0x24,
+	0xdd, 0x20, 0x1b, 0x7b, 0x21, 0x84, 0xa0, 0x61, 0x2c, 0x7d, 0x65, 0x8c, 0x06, 0x2d, 0xd0, 0xde,
+	0xd6, 0xd1, 0xf8, 0xd6, 0x4b, 0x89, 0xd4, 0xce, 0x33, 0xcf, 0x81, 0xe2, 0x4a, 0x58, 0x67, 0xa4,
+	0x32, 0xe3, 0x31, 0x9f, 0xa2, 0x6d, 0x36, 0x4d, 0xb7, 0xda, 0x69, 0xd1, 0xdf, 0x33, 0xd0, 0x2b,
+	0x8d, 0xec, 0x96, 0x17, 0x6f, 0x87, 0x46, 0x7f, 0xc3, 0xb3, 0x2e, 0xc9, 0x4e, 0x2c, 0x86, 0x10,
+	0x07, 0x68, 0xff, 0x6b, 0x96, 0xdc, 0x6a, 0x87, 0x15, 0x49, 0x5c, 0xc3, 0x58, 0x44, 0xf2, 0x49,
+	0x0c, 0x46, 0x3c, 0x16, 0x7d, 0xcd, 0xdb, 0xe8, 0xe5, 0x2a, 0xd6, 0x11, 0xa9, 0x4f, 0x38, 0x2a,
+	0x2f, 0xfb, 0x7b, 0x32, 0xb0, 0x4b, 0x4d, 0xd3, 0x2d, 0xf7, 0x6b, 0xe9, 0x34, 0xa3, 0xf4, 0x02,
+	0x0b, 0xc8, 0x41, 0xc2, 0x27, 0x32, 0xe0, 0x0a, 0x62, 0x2f, 0xf3, 0xf2, 0x30, 0x15, 0x45, 0xbb,
+	0xac, 0x63, 0xb4, 0x8b, 0x62, 0xdc, 0xe4, 0xd4, 0x0b, 0xbd, 0xd6, 0x69, 0xf2, 0xc3, 0xf6, 0x93,
+	0x9f, 0x96, 0xd6, 0x2d, 0xd9, 0xfd, 0x34, 0xf4, 0x21, 0x0a, 0xd0, 0xfe, 0xaf, 0x8d, 0x8e, 0xff,
+	0x64, 0xd4, 0x85, 0x28, 0xbf, 0xb4, 0x9e, 0x7c, 0x1d, 0x62, 0xb7, 0xb7, 0x58, 0x39, 0xe6, 0x72,
+	0xe5, 0x98, 0xef, 0x2b, 0xc7, 0x7c, 0x5e, 0x3b, 0xc6, 0x72, 0xed, 0x18, 0xaf, 0x6b, 0xc7, 0xb8,
+	0x63, 0xa1, 0x54, 0xa3, 0xb9, 0x4f, 0x87, 0x30, 0x65, 0x43, 0xc0, 0x29, 0x20, 0xd3, 0x85, 0x27,
+	0xa7, 0xec, 0xe1, 0x5b, 0xeb, 0xea, 0x71, 0x26, 0xd0, 0xaf, 0xe8, 0x9a, 0x4f, 0x3e, 0x06, 0x00,
+	0xb2, 0x39, 0x72, 0x48, 0x74, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorBonds) > 0 {
+		for iNdEx := len(m.ValidatorBonds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorBonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValidatorLiquidShares) > 0 {
+		for iNdEx := len(m.ValidatorLiquidShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorLiquidShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LastRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastRecordId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastRecordId))
+	}
+	if len(m.ValidatorLiquidShares) > 0 {
+		for _, e := range m.ValidatorLiquidShares {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorBonds) > 0 {
+		for _, e := range m.ValidatorBonds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, TokenizeShareRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRecordId", wireType)
+			}
+			m.LastRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorLiquidShares = append(m.ValidatorLiquidShares, ValidatorLiquidShares{})
+			if err := m.ValidatorLiquidShares[len(m.ValidatorLiquidShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBonds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBonds = append(m.ValidatorBonds, ValidatorBond{})
+			if err := m.ValidatorBonds[len(m.ValidatorBonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the name of the liquidstaking module.
+	ModuleName = "liquidstaking"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key.
+	RouterKey = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// LastRecordIDKey holds the id of the last created tokenize share record.
+	LastRecordIDKey = []byte{0x01}
+	// RecordKeyPrefix prefixes the tokenize share records, by id.
+	RecordKeyPrefix = []byte{0x02}
+	// RecordByOwnerKeyPrefix prefixes the index of the tokenize share records
+	// by owner address.
+	RecordByOwnerKeyPrefix = []byte{0x03}
+	// ValidatorLiquidSharesKeyPrefix prefixes the tokenized delegator shares
+	// of the validators, by operator address.
+	ValidatorLiquidSharesKeyPrefix = []byte{0x04}
+	// ValidatorBondKeyPrefix prefixes the delegations designated as validator
+	// bonds, by validator and delegator address.
+	ValidatorBondKeyPrefix = []byte{0x05}
+	// PendingSharesKeyPrefix prefixes the shares of the tracked delegations
+	// being modified, by delegator and validator address. They are only set
+	// between the staking hooks called before and after a modification.
+	PendingSharesKeyPrefix = []byte{0x06}
+)
+
+// RecordKey returns the key of a tokenize share record.
+func RecordKey(id uint64) []byte {
+	return append(RecordKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// RecordByOwnerPrefix returns the prefix of the index of the tokenize share
+// records of an owner.
+func RecordByOwnerPrefix(owner []byte) []byte {
+	return append(RecordByOwnerKeyPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// RecordByOwnerKey returns the key of a tokenize share record in the index of
+// the records of its owner.
+func RecordByOwnerKey(owner []byte, id uint64) []byte {
+	return append(RecordByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ValidatorLiquidSharesKey returns the key of the tokenized delegator shares
+// of a validator.
+func ValidatorLiquidSharesKey(validator []byte) []byte {
+	return append(ValidatorLiquidSharesKeyPrefix, address.MustLengthPrefix(validator)...)
+}
+
+// ValidatorBondsPrefix returns the prefix of the validator bonds of a
+// validator.
+func ValidatorBondsPrefix(validator []byte) []byte {
+	return append(ValidatorBondKeyPrefix, address.MustLengthPrefix(validator)...)
+}
+
+// ValidatorBondKey returns the key of a validator bond.
+func ValidatorBondKey(validator, delegator []byte) []byte {
+	return append(ValidatorBondsPrefix(validator), address.MustLengthPrefix(delegator)...)
+}
+
+// PendingSharesKey returns the key of the shares of a tracked delegation
+// being modified.
+func PendingSharesKey(delegator, validator []byte) []byte {
+	key := append(PendingSharesKeyPrefix, address.MustLengthPrefix(delegator)...)
+	return append(key, address.MustLengthPrefix(validator)...)
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"sigs.k8s.io/yaml"
+)
+
+// Default parameter values
+var (
+	DefaultGlobalLiquidStakingCap    = sdk.NewDecWithPrec(25, 2)
+	DefaultValidatorLiquidStakingCap = sdk.NewDecWithPrec(50, 2)
+	DefaultValidatorBondFactor       = sdk.NewDec(250)
+)
+
+// Parameter store keys
+var (
+	KeyGlobalLiquidStakingCap    = []byte("GlobalLiquidStakingCap")
+	KeyValidatorLiquidStakingCap = []byte("ValidatorLiquidStakingCap")
+	KeyValidatorBondFactor       = []byte("ValidatorBondFactor")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table of the liquidstaking module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(globalLiquidStakingCap, validatorLiquidStakingCap, validatorBondFactor sdk.Dec) Params {
+	return Params{
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+		ValidatorBondFactor:       validatorBondFactor,
+	}
+}
+
+// DefaultParams returns the default parameters of the liquidstaking module.
+func DefaultParams() Params {
+	return NewParams(DefaultGlobalLiquidStakingCap, DefaultValidatorLiquidStakingCap, DefaultValidatorBondFactor)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateCap),
+		paramtypes.NewParamSetPair(KeyValidatorLiquidStakingCap, &p.ValidatorLiquidStakingCap, validateCap),
+		paramtypes.NewParamSetPair(KeyValidatorBondFactor, &p.ValidatorBondFactor, validateValidatorBondFactor),
+	}
+}
+
+// Validate validates the parameters.
+func (p Params) Validate() error {
+	if err := validateCap(p.GlobalLiquidStakingCap); err != nil {
+		return fmt.Errorf("invalid global liquid staking cap: %w", err)
+	}
+	if err := validateCap(p.ValidatorLiquidStakingCap); err != nil {
+		return fmt.Errorf("invalid validator liquid staking cap: %w", err)
+	}
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return fmt.Errorf("invalid validator bond factor: %w", err)
+	}
+
+	return nil
+}
+
+// ValidatorBondRequired returns whether the validators must hold validator
+// bonds to receive liquid shares.
+func (p Params) ValidatorBondRequired() bool {
+	return !p.ValidatorBondFactor.IsNegative()
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("cap must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateValidatorBondFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return fmt.Errorf("validator bond factor must not be nil")
+	}
+
+	return nil
+}
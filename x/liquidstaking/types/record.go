@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, validator sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: RecordModuleAddress(id).String(),
+		Validator:     validator.String(),
+	}
+}
+
+// RecordModuleAccountName returns the name of the module account holding the
+// delegation of a tokenize share record.
+func RecordModuleAccountName(id uint64) string {
+	return fmt.Sprintf("%s_record_%d", ModuleName, id)
+}
+
+// RecordModuleAddress returns the address of the module account holding the
+// delegation of a tokenize share record.
+func RecordModuleAddress(id uint64) sdk.AccAddress {
+	return authtypes.NewModuleAddress(RecordModuleAccountName(id))
+}
+
+// ShareTokenDenom returns the denom of the share tokens of a tokenize share
+// record, the operator address of its validator followed by its id.
+func ShareTokenDenom(validator string, id uint64) string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(validator), id)
+}
+
+// ParseShareTokenDenom returns the operator address of the validator and the
+// id of the tokenize share record of a share token denom.
+func ParseShareTokenDenom(denom string) (sdk.ValAddress, uint64, error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 2 {
+		return nil, 0, ErrInvalidShareDenom.Wrap(denom)
+	}
+	validator, err := sdk.ValAddressFromBech32(parts[0])
+	if err != nil {
+		return nil, 0, ErrInvalidShareDenom.Wrapf("%s: %s", denom, err)
+	}
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, 0, ErrInvalidShareDenom.Wrapf("%s: %s", denom, err)
+	}
+
+	return validator, id, nil
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return ShareTokenDenom(r.Validator, r.Id)
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return RecordModuleAddress(r.Id)
+}
+
+// GetOwnerAddr returns the address of the owner of the record.
+func (r TokenizeShareRecord) GetOwnerAddr() sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(r.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return owner
+}
+
+// GetValidatorAddr returns the operator address of the validator of the
+// record.
+func (r TokenizeShareRecord) GetValidatorAddr() sdk.ValAddress {
+	validator, err := sdk.ValAddressFromBech32(r.Validator)
+	if err != nil {
+		panic(err)
+	}
+
+	return validator
+}
+
+// NewValidatorBond creates a new ValidatorBond instance.
+func NewValidatorBond(delegator sdk.AccAddress, validator sdk.ValAddress, shares sdk.Dec) ValidatorBond {
+	return ValidatorBond{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+		Shares:           shares,
+	}
+}
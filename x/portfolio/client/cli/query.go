@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/portfolio/types"
+)
+
+// Flags of the balance history query command.
+const (
+	FlagFromHeight = "from-height"
+	FlagToHeight   = "to-height"
+	FlagStep       = "step"
+)
+
+// GetQueryCmd returns the cli query commands for the portfolio query service.
+func GetQueryCmd() *cobra.Command {
+	portfolioQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for aggregated views of the accounts",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	portfolioQueryCmd.AddCommand(
+		GetCmdQueryBalanceHistory(),
+		GetCmdQueryAccountOverview(),
+	)
+
+	return portfolioQueryCmd
+}
+
+// GetCmdQueryBalanceHistory implements the balance history query command.
+func GetCmdQueryBalanceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-history [address] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the balance of an account at a series of heights",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balance of an account in a denom at every step heights from the
+from height to the to height, read from the historical state kept by the
+queried node. The series defaults to every height kept by the node, and the
+query fails, listing the available heights, if a height has been pruned.
+
+Example:
+$ %s query %s balance-history cosmos1qnk2n4nlkpw9xfqntladh74w6ujtulwn7j8za9 uatom --from-height 100000 --to-height 110000 --step 1000
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+			step, err := cmd.Flags().GetUint64(FlagStep)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BalanceHistory(cmd.Context(), &types.QueryBalanceHistoryRequest{
+				Address:    args[0],
+				Denom:      args[1],
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Step:       step,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "First height of the series, the earliest height kept by the node if 0")
+	cmd.Flags().Int64(FlagToHeight, 0, "Last height of the series, the latest height if 0")
+	cmd.Flags().Uint64(FlagStep, 1, "Number of blocks between two heights of the series")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAccountOverview implements the account overview query command.
+func GetCmdQueryAccountOverview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "overview [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the balances, staking positions, rewards, vesting and grants of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balances, delegations with their pending rewards, unbonding
+delegations, redelegations, vesting status, authz grants and fee allowances
+of an account, all read at the same height.
+
+Example:
+$ %s query %s overview cosmos1qnk2n4nlkpw9xfqntladh74w6ujtulwn7j8za9
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountOverview(cmd.Context(), &types.QueryAccountOverviewRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/portfolio/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the portfolio gRPC query service. Balance histories are
+// read from the historical versions of the bank store kept by the commit
+// multistore of the node, so the available heights depend on its pruning
+// configuration. Account overviews are read from the query context, so all
+// their sections are at the same height.
+type Querier struct {
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	stakingKeeper  types.StakingKeeper
+	distrKeeper    types.DistrKeeper
+	authzKeeper    types.AuthzKeeper
+	feeGrantKeeper types.FeeGrantKeeper
+
+	cms     storetypes.CommitMultiStore
+	bankKey storetypes.StoreKey
+}
+
+// NewQuerier returns a new portfolio Querier.
+func NewQuerier(
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistrKeeper,
+	authzKeeper types.AuthzKeeper,
+	feeGrantKeeper types.FeeGrantKeeper,
+	cms storetypes.CommitMultiStore,
+	bankKey storetypes.StoreKey,
+) Querier {
+	return Querier{
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		stakingKeeper:  stakingKeeper,
+		distrKeeper:    distrKeeper,
+		authzKeeper:    authzKeeper,
+		feeGrantKeeper: feeGrantKeeper,
+		cms:            cms,
+		bankKey:        bankKey,
+	}
+}
+
+// BalanceHistory implements the Query/BalanceHistory gRPC method.
+func (q Querier) BalanceHistory(_ context.Context, req *types.QueryBalanceHistoryRequest) (*types.QueryBalanceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	store, ok := q.cms.GetCommitKVStore(q.bankKey).(*iavl.Store)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the bank store does not keep historical versions")
+	}
+
+	from, to, step := req.FromHeight, req.ToHeight, int64(req.Step)
+	if to == 0 {
+		to = q.cms.LastCommitID().Version
+	}
+	if from == 0 {
+		from, _ = availableHeights(store)
+	}
+	if step == 0 {
+		step = 1
+	}
+	if from <= 0 || to < from || step < 0 {
+		return nil, status.Error(codes.InvalidArgument, "from height must be positive and not after to height, and step must not be negative")
+	}
+	if latest := q.cms.LastCommitID().Version; to > latest {
+		return nil, status.Errorf(codes.InvalidArgument, "to height %d is after the latest height %d", to, latest)
+	}
+	if count := (to-from)/step + 1; count > types.MaxHistoryHeights {
+		return nil, status.Errorf(codes.InvalidArgument, "range spans %d heights, more than the maximum of %d", count, types.MaxHistoryHeights)
+	}
+
+	var balances []types.HeightBalance
+	for height := from; height <= to; height += step {
+		ctx, err := q.historicalContext(store, height)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, types.HeightBalance{
+			Height:  height,
+			Balance: q.bankKeeper.GetBalance(ctx, addr, req.Denom),
+		})
+	}
+
+	return &types.QueryBalanceHistoryResponse{Balances: balances}, nil
+}
+
+// historicalContext returns a context reading the bank store at a height. It
+// fails with codes.OutOfRange, listing the available heights, if the height
+// has been pruned.
+func (q Querier) historicalContext(store *iavl.Store, height int64) (sdk.Context, error) {
+	// an immutable tree of a missing version is empty, so check it first
+	if !store.VersionExists(height) {
+		earliest, latest := availableHeights(store)
+		return sdk.Context{}, status.Errorf(codes.OutOfRange, "height %d is not available, the node keeps the heights from %d to %d", height, earliest, latest)
+	}
+
+	version, err := store.GetImmutable(height)
+	if err != nil {
+		return sdk.Context{}, status.Error(codes.Internal, fmt.Sprintf("failed to load height %d: %s", height, err))
+	}
+
+	ms := cachemulti.NewStore(
+		dbm.NewMemDB(),
+		map[storetypes.StoreKey]storetypes.CacheWrapper{q.bankKey: version},
+		map[string]storetypes.StoreKey{q.bankKey.Name(): q.bankKey},
+		nil, nil, nil,
+	)
+
+	return sdk.NewContext(ms, tmproto.Header{Height: height}, false, log.NewNopLogger()), nil
+}
+
+// availableHeights returns the earliest and latest heights of the store.
+func availableHeights(store *iavl.Store) (int64, int64) {
+	versions := store.GetAllVersions()
+	if len(versions) == 0 {
+		return 0, 0
+	}
+
+	return int64(versions[0]), int64(versions[len(versions)-1])
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/portfolio/types"
+)
+
+// mockBankKeeper stores the balances of a single account by denom.
+type mockBankKeeper struct {
+	key storetypes.StoreKey
+}
+
+func (k mockBankKeeper) GetBalance(ctx sdk.Context, _ sdk.AccAddress, denom string) sdk.Coin {
+	amount, ok := sdk.NewIntFromString(string(ctx.KVStore(k.key).Get([]byte(denom))))
+	if !ok {
+		return sdk.NewInt64Coin(denom, 0)
+	}
+
+	return sdk.NewCoin(denom, amount)
+}
+
+func (k mockBankKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	var balances sdk.Coins
+	iterator := ctx.KVStore(k.key).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		balances = balances.Add(k.GetBalance(ctx, addr, string(iterator.Key())))
+	}
+
+	return balances
+}
+
+func TestBalanceHistory(t *testing.T) {
+	key := storetypes.NewKVStoreKey("bank")
+	cms := rootmulti.NewStore(dbm.NewMemDB())
+	cms.SetPruning(storetypes.NewPruningOptions(3, 1))
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	for height := 1; height <= 10; height++ {
+		if height%2 == 0 {
+			cms.GetKVStore(key).Set([]byte("uatom"), []byte(sdk.NewInt(int64(height)).String()))
+		}
+		cms.Commit()
+	}
+
+	q := NewQuerier(nil, mockBankKeeper{key: key}, nil, nil, nil, nil, cms, key)
+	addr := sdk.AccAddress("addr________________").String()
+	history := func(from, to int64, step uint64) ([]types.HeightBalance, error) {
+		res, err := q.BalanceHistory(context.Background(), &types.QueryBalanceHistoryRequest{
+			Address:    addr,
+			Denom:      "uatom",
+			FromHeight: from,
+			ToHeight:   to,
+			Step:       step,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res.Balances, nil
+	}
+
+	balances, err := history(8, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, []types.HeightBalance{
+		{Height: 8, Balance: sdk.NewInt64Coin("uatom", 8)},
+		{Height: 9, Balance: sdk.NewInt64Coin("uatom", 8)},
+		{Height: 10, Balance: sdk.NewInt64Coin("uatom", 10)},
+	}, balances)
+
+	// the series defaults to the available heights
+	balances, err = history(0, 0, 2)
+	require.NoError(t, err)
+	require.NotEmpty(t, balances)
+	require.Less(t, int64(1), balances[0].Height)
+	require.LessOrEqual(t, balances[len(balances)-1].Height, int64(10))
+
+	// pruned heights are reported with the available range
+	_, err = history(1, 10, 1)
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+	require.Contains(t, err.Error(), "height 1 is not available, the node keeps the heights from")
+
+	for _, req := range []struct{ from, to int64 }{{9, 8}, {1, 11}} {
+		_, err = history(req.from, req.to, 1)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
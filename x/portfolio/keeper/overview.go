@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/portfolio/types"
+)
+
+// allPages requests all the results of a paginated query in a single page.
+var allPages = &query.PageRequest{Limit: query.MaxLimit}
+
+// AccountOverview implements the Query/AccountOverview gRPC method.
+func (q Querier) AccountOverview(c context.Context, req *types.QueryAccountOverviewRequest) (*types.QueryAccountOverviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryAccountOverviewResponse{
+		Height:   ctx.BlockHeight(),
+		Balances: q.bankKeeper.GetAllBalances(ctx, addr),
+	}
+
+	if res.Delegations, res.TotalRewards, err = q.delegations(ctx, addr); err != nil {
+		return nil, err
+	}
+	for _, ubd := range q.stakingKeeper.GetAllUnbondingDelegations(ctx, addr) {
+		overview := types.UnbondingDelegationOverview{ValidatorAddress: ubd.ValidatorAddress}
+		for _, entry := range ubd.Entries {
+			overview.Entries = append(overview.Entries, stakingEntry(entry.CreationHeight, entry.CompletionTime, entry.InitialBalance, entry.Balance))
+		}
+		res.UnbondingDelegations = append(res.UnbondingDelegations, overview)
+	}
+	for _, red := range q.stakingKeeper.GetAllRedelegations(ctx, addr, nil, nil) {
+		overview := types.RedelegationOverview{
+			ValidatorSrcAddress: red.ValidatorSrcAddress,
+			ValidatorDstAddress: red.ValidatorDstAddress,
+		}
+		for _, entry := range red.Entries {
+			overview.Entries = append(overview.Entries, stakingEntry(entry.CreationHeight, entry.CompletionTime, entry.InitialBalance, q.redelegationBalance(ctx, red, entry)))
+		}
+		res.Redelegations = append(res.Redelegations, overview)
+	}
+
+	if acc, ok := q.accountKeeper.GetAccount(ctx, addr).(vestexported.VestingAccount); ok {
+		res.Vesting = &types.VestingOverview{
+			Type:             "/" + proto.MessageName(acc),
+			StartTime:        acc.GetStartTime(),
+			EndTime:          acc.GetEndTime(),
+			OriginalVesting:  acc.GetOriginalVesting(),
+			Vested:           acc.GetVestedCoins(ctx.BlockTime()),
+			Vesting:          acc.GetVestingCoins(ctx.BlockTime()),
+			DelegatedFree:    acc.GetDelegatedFree(),
+			DelegatedVesting: acc.GetDelegatedVesting(),
+		}
+	}
+
+	if res.GrantedGrants, res.ReceivedGrants, err = q.grants(ctx, req.Address); err != nil {
+		return nil, err
+	}
+	if res.GrantedAllowances, res.ReceivedAllowances, err = q.allowances(ctx, req.Address); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// delegations returns the delegations of an account with their pending
+// rewards, and the total of the rewards.
+func (q Querier) delegations(ctx sdk.Context, addr sdk.AccAddress) ([]types.DelegationOverview, sdk.DecCoins, error) {
+	delegations := q.stakingKeeper.GetAllDelegatorDelegations(ctx, addr)
+	if len(delegations) == 0 {
+		return nil, nil, nil
+	}
+
+	// computing the rewards increments the validator periods, so discard its
+	// writes
+	cacheCtx, _ := ctx.CacheContext()
+	rewards, err := q.distrKeeper.DelegationTotalRewards(sdk.WrapSDKContext(cacheCtx), &distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: addr.String()})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to compute the rewards: %s", err)
+	}
+	rewardsByValidator := make(map[string]sdk.DecCoins, len(rewards.Rewards))
+	for _, reward := range rewards.Rewards {
+		rewardsByValidator[reward.ValidatorAddress] = reward.Reward
+	}
+
+	bondDenom := q.stakingKeeper.BondDenom(ctx)
+	overviews := make([]types.DelegationOverview, 0, len(delegations))
+	for _, delegation := range delegations {
+		validator, found := q.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			return nil, nil, status.Errorf(codes.Internal, "validator %s of a delegation not found", delegation.ValidatorAddress)
+		}
+
+		overviews = append(overviews, types.DelegationOverview{
+			ValidatorAddress: delegation.ValidatorAddress,
+			Shares:           delegation.Shares,
+			Balance:          sdk.NewCoin(bondDenom, validator.TokensFromShares(delegation.Shares).TruncateInt()),
+			Rewards:          rewardsByValidator[delegation.ValidatorAddress],
+		})
+	}
+
+	return overviews, rewards.Total, nil
+}
+
+// redelegationBalance returns the amount of tokens the destination shares of
+// a redelegation entry are worth.
+func (q Querier) redelegationBalance(ctx sdk.Context, red stakingtypes.Redelegation, entry stakingtypes.RedelegationEntry) sdk.Int {
+	valAddr, err := sdk.ValAddressFromBech32(red.ValidatorDstAddress)
+	if err != nil {
+		return sdk.ZeroInt()
+	}
+	validator, found := q.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	return validator.TokensFromShares(entry.SharesDst).TruncateInt()
+}
+
+// grants returns the authz grants given by and to an account.
+func (q Querier) grants(ctx sdk.Context, addr string) (granted, received []types.GrantOverview, err error) {
+	c := sdk.WrapSDKContext(ctx)
+
+	granterRes, err := q.authzKeeper.GranterGrants(c, &authz.QueryGranterGrantsRequest{Granter: addr, Pagination: allPages})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to read the granted grants: %s", err)
+	}
+	granteeRes, err := q.authzKeeper.GranteeGrants(c, &authz.QueryGranteeGrantsRequest{Grantee: addr, Pagination: allPages})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to read the received grants: %s", err)
+	}
+
+	return grantOverviews(granterRes.Grants), grantOverviews(granteeRes.Grants), nil
+}
+
+// allowances returns the fee allowances given by and to an account.
+func (q Querier) allowances(ctx sdk.Context, addr string) (granted, received []types.AllowanceOverview, err error) {
+	c := sdk.WrapSDKContext(ctx)
+
+	granterRes, err := q.feeGrantKeeper.AllowancesByGranter(c, &feegrant.QueryAllowancesByGranterRequest{Granter: addr, Pagination: allPages})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to read the granted allowances: %s", err)
+	}
+	granteeRes, err := q.feeGrantKeeper.Allowances(c, &feegrant.QueryAllowancesRequest{Grantee: addr, Pagination: allPages})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to read the received allowances: %s", err)
+	}
+
+	return allowanceOverviews(granterRes.Allowances), allowanceOverviews(granteeRes.Allowances), nil
+}
+
+func stakingEntry(creationHeight int64, completionTime time.Time, initialBalance, balance sdk.Int) types.StakingEntry {
+	return types.StakingEntry{
+		CreationHeight: creationHeight,
+		CompletionTime: completionTime.Unix(),
+		InitialBalance: initialBalance,
+		Balance:        balance,
+	}
+}
+
+func grantOverviews(grants []*authz.GrantAuthorization) []types.GrantOverview {
+	overviews := make([]types.GrantOverview, 0, len(grants))
+	for _, grant := range grants {
+		var expiration int64
+		if grant.Expiration != nil {
+			expiration = grant.Expiration.Unix()
+		}
+
+		overviews = append(overviews, types.GrantOverview{
+			Granter:       grant.Granter,
+			Grantee:       grant.Grantee,
+			Authorization: grant.Authorization,
+			Expiration:    expiration,
+		})
+	}
+
+	return overviews
+}
+
+func allowanceOverviews(grants []*feegrant.Grant) []types.AllowanceOverview {
+	overviews := make([]types.AllowanceOverview, 0, len(grants))
+	for _, grant := range grants {
+		overviews = append(overviews, types.AllowanceOverview{
+			Granter:   grant.Granter,
+			Grantee:   grant.Grantee,
+			Allowance: grant.Allowance,
+		})
+	}
+
+	return overviews
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/portfolio/types"
+)
+
+var (
+	overviewAddr = sdk.AccAddress("addr________________")
+	otherAddr    = sdk.AccAddress("other_______________")
+	valAddr      = sdk.ValAddress("val_________________")
+)
+
+type mockAccountKeeper struct{ acc authtypes.AccountI }
+
+func (k mockAccountKeeper) GetAccount(sdk.Context, sdk.AccAddress) authtypes.AccountI { return k.acc }
+
+// mockStakingKeeper has a single validator whose shares are worth half a
+// token.
+type mockStakingKeeper struct{}
+
+func (mockStakingKeeper) BondDenom(sdk.Context) string { return "uatom" }
+
+func (mockStakingKeeper) GetValidator(_ sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+	if !addr.Equals(valAddr) {
+		return stakingtypes.Validator{}, false
+	}
+	return stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Tokens:          sdk.NewInt(500),
+		DelegatorShares: sdk.NewDec(1000),
+	}, true
+}
+
+func (mockStakingKeeper) GetAllDelegatorDelegations(sdk.Context, sdk.AccAddress) []stakingtypes.Delegation {
+	return []stakingtypes.Delegation{stakingtypes.NewDelegation(overviewAddr, valAddr, sdk.NewDec(100))}
+}
+
+func (mockStakingKeeper) GetAllUnbondingDelegations(sdk.Context, sdk.AccAddress) []stakingtypes.UnbondingDelegation {
+	return []stakingtypes.UnbondingDelegation{
+		stakingtypes.NewUnbondingDelegation(overviewAddr, valAddr, 5, time.Unix(1000, 0), sdk.NewInt(30)),
+	}
+}
+
+func (mockStakingKeeper) GetAllRedelegations(sdk.Context, sdk.AccAddress, sdk.ValAddress, sdk.ValAddress) []stakingtypes.Redelegation {
+	return []stakingtypes.Redelegation{
+		stakingtypes.NewRedelegation(overviewAddr, sdk.ValAddress("src_________________"), valAddr, 6, time.Unix(2000, 0), sdk.NewInt(20), sdk.NewDec(40)),
+	}
+}
+
+type mockDistrKeeper struct{}
+
+func (mockDistrKeeper) DelegationTotalRewards(context.Context, *distrtypes.QueryDelegationTotalRewardsRequest) (*distrtypes.QueryDelegationTotalRewardsResponse, error) {
+	rewards := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(15, 1)))
+	return &distrtypes.QueryDelegationTotalRewardsResponse{
+		Rewards: []distrtypes.DelegationDelegatorReward{{ValidatorAddress: valAddr.String(), Reward: rewards}},
+		Total:   rewards,
+	}, nil
+}
+
+type mockAuthzKeeper struct{ authorization *codectypes.Any }
+
+func (k mockAuthzKeeper) GranterGrants(_ context.Context, req *authz.QueryGranterGrantsRequest) (*authz.QueryGranterGrantsResponse, error) {
+	return &authz.QueryGranterGrantsResponse{Grants: []*authz.GrantAuthorization{
+		{Granter: req.Granter, Grantee: otherAddr.String(), Authorization: k.authorization},
+	}}, nil
+}
+
+func (k mockAuthzKeeper) GranteeGrants(context.Context, *authz.QueryGranteeGrantsRequest) (*authz.QueryGranteeGrantsResponse, error) {
+	return &authz.QueryGranteeGrantsResponse{}, nil
+}
+
+type mockFeeGrantKeeper struct{ allowance *codectypes.Any }
+
+func (k mockFeeGrantKeeper) Allowances(_ context.Context, req *feegrant.QueryAllowancesRequest) (*feegrant.QueryAllowancesResponse, error) {
+	return &feegrant.QueryAllowancesResponse{Allowances: []*feegrant.Grant{
+		{Granter: otherAddr.String(), Grantee: req.Grantee, Allowance: k.allowance},
+	}}, nil
+}
+
+func (k mockFeeGrantKeeper) AllowancesByGranter(context.Context, *feegrant.QueryAllowancesByGranterRequest) (*feegrant.QueryAllowancesByGranterResponse, error) {
+	return &feegrant.QueryAllowancesByGranterResponse{}, nil
+}
+
+func TestAccountOverview(t *testing.T) {
+	original := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	acc := vestingtypes.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(overviewAddr), original, 0, 100)
+	authorization, err := codectypes.NewAnyWithValue(banktypes.NewSendAuthorization(original))
+	require.NoError(t, err)
+	allowance, err := codectypes.NewAnyWithValue(&feegrant.BasicAllowance{})
+	require.NoError(t, err)
+
+	key := storetypes.NewKVStoreKey("bank")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_bank")).
+		WithBlockHeader(tmproto.Header{Height: 7, Time: time.Unix(25, 0)})
+	ctx.KVStore(key).Set([]byte("uatom"), []byte("150"))
+	ctx.KVStore(key).Set([]byte("stake"), []byte("1"))
+
+	q := NewQuerier(
+		mockAccountKeeper{acc: acc},
+		mockBankKeeper{key: key},
+		mockStakingKeeper{},
+		mockDistrKeeper{},
+		mockAuthzKeeper{authorization: authorization},
+		mockFeeGrantKeeper{allowance: allowance},
+		nil, nil,
+	)
+	res, err := q.AccountOverview(sdk.WrapSDKContext(ctx), &types.QueryAccountOverviewRequest{Address: overviewAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, int64(7), res.Height)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 150), sdk.NewInt64Coin("stake", 1)), res.Balances)
+
+	rewards := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(15, 1)))
+	require.Equal(t, []types.DelegationOverview{{
+		ValidatorAddress: valAddr.String(),
+		Shares:           sdk.NewDec(100),
+		Balance:          sdk.NewInt64Coin("uatom", 50),
+		Rewards:          rewards,
+	}}, res.Delegations)
+	require.Equal(t, rewards, res.TotalRewards)
+	require.Equal(t, []types.StakingEntry{{CreationHeight: 5, CompletionTime: 1000, InitialBalance: sdk.NewInt(30), Balance: sdk.NewInt(30)}}, res.UnbondingDelegations[0].Entries)
+	// the 40 destination shares are worth 20 tokens
+	require.Equal(t, []types.StakingEntry{{CreationHeight: 6, CompletionTime: 2000, InitialBalance: sdk.NewInt(20), Balance: sdk.NewInt(20)}}, res.Redelegations[0].Entries)
+
+	require.Equal(t, &types.VestingOverview{
+		Type:            "/cosmos.vesting.v1beta1.ContinuousVestingAccount",
+		EndTime:         100,
+		OriginalVesting: original,
+		Vested:          sdk.NewCoins(sdk.NewInt64Coin("uatom", 25)),
+		Vesting:         sdk.NewCoins(sdk.NewInt64Coin("uatom", 75)),
+	}, res.Vesting)
+
+	require.Equal(t, []types.GrantOverview{{Granter: overviewAddr.String(), Grantee: otherAddr.String(), Authorization: authorization}}, res.GrantedGrants)
+	require.Empty(t, res.ReceivedGrants)
+	require.Empty(t, res.GrantedAllowances)
+	require.Equal(t, []types.AllowanceOverview{{Granter: otherAddr.String(), Grantee: overviewAddr.String(), Allowance: allowance}}, res.ReceivedAllowances)
+
+	// plain accounts have no vesting status
+	q.accountKeeper = mockAccountKeeper{acc: authtypes.NewBaseAccountWithAddress(overviewAddr)}
+	res, err = q.AccountOverview(sdk.WrapSDKContext(ctx), &types.QueryAccountOverviewRequest{Address: overviewAddr.String()})
+	require.NoError(t, err)
+	require.Nil(t, res.Vesting)
+
+	_, err = q.AccountOverview(sdk.WrapSDKContext(ctx), &types.QueryAccountOverviewRequest{Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,9 @@
+package types
+
+const (
+	// ModuleName defines the name of the portfolio query service.
+	ModuleName = "portfolio"
+
+	// MaxHistoryHeights is the maximum number of heights of a balance history.
+	MaxHistoryHeights = 1000
+)
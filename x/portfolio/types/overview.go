@@ -0,0 +1,45 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = QueryAccountOverviewResponse{}
+	_ codectypes.UnpackInterfacesMessage = GrantOverview{}
+	_ codectypes.UnpackInterfacesMessage = AllowanceOverview{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryAccountOverviewResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, grants := range [][]GrantOverview{res.GrantedGrants, res.ReceivedGrants} {
+		for _, grant := range grants {
+			if err := grant.UnpackInterfaces(unpacker); err != nil {
+				return err
+			}
+		}
+	}
+	for _, allowances := range [][]AllowanceOverview{res.GrantedAllowances, res.ReceivedAllowances} {
+		for _, allowance := range allowances {
+			if err := allowance.UnpackInterfaces(unpacker); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GrantOverview) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var authorization authz.Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a AllowanceOverview) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var allowance feegrant.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/ratelimit/types"
+)
+
+// GetQueryCmd returns the cli query commands for the ratelimit module.
+func GetQueryCmd() *cobra.Command {
+	ratelimitQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the ratelimit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	ratelimitQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryUsage(),
+		GetCmdQueryUsages(),
+	)
+
+	return ratelimitQueryCmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the rate limits of the IBC transfers",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUsage implements the usage query command.
+func GetCmdQueryUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage [channel-id] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the usage of the rate limit of a denom through a channel in the current window",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimitUsage(cmd.Context(), &types.QueryRateLimitUsageRequest{
+				ChannelId: args[0],
+				Denom:     args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUsages implements the usages query command.
+func GetCmdQueryUsages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usages",
+		Args:  cobra.NoArgs,
+		Short: "Query the usages of all the rate limits in the current windows",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimitUsages(cmd.Context(), &types.QueryRateLimitUsagesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/cosmos/gaia/v8/x/ratelimit/keeper"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the transfer IBC module to enforce the rate limits on
+// the received packets, and to refund the outflows of the sent packets which
+// fail. The outflows are checked when the packets are sent, by the keeper
+// wrapping the ICS4 wrapper of the transfer keeper. All the other callbacks
+// are passed through to the wrapped module.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the given transfer
+// IBC module.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. A packet exceeding the
+// inflow quota of its denom is rejected with an error acknowledgement, so
+// that the tokens are refunded on the sender chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	if err := im.keeper.ReceiveTransfer(ctx, packet); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The pending
+// send packet is settled on every path: when the wrapped module fails, the
+// acknowledgement is reverted and the packet stays pending along with it,
+// and an acknowledgement which cannot be decoded settles the packet without
+// refunding its outflow.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	failed := false
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil {
+		failed = !ack.Success()
+	}
+	im.keeper.SettleSendPacket(ctx, packet.SourceChannel, packet.Sequence, failed)
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.SettleSendPacket(ctx, packet.SourceChannel, packet.Sequence, true)
+
+	return nil
+}
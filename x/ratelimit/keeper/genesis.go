@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/ratelimit/types"
+)
+
+// InitGenesis initializes the ratelimit module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, flow := range genState.Flows {
+		k.SetFlow(ctx, flow)
+	}
+	for _, packet := range genState.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, packet)
+	}
+}
+
+// ExportGenesis returns the ratelimit module's genesis state. Only the flows
+// of the current rate limits are exported.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params := k.GetParams(ctx)
+
+	var flows []types.Flow
+	for _, rateLimit := range params.RateLimits {
+		if flow, found := k.GetFlow(ctx, rateLimit.ChannelId, rateLimit.Denom); found {
+			flows = append(flows, flow)
+		}
+	}
+
+	return types.NewGenesisState(params, flows, k.GetAllPendingSendPackets(ctx))
+}
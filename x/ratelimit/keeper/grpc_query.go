@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/ratelimit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// RateLimitUsage implements the Query/RateLimitUsage gRPC method.
+func (k Keeper) RateLimitUsage(c context.Context, req *types.QueryRateLimitUsageRequest) (*types.QueryRateLimitUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimit, found := k.GetParams(ctx).RateLimit(req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "%s is not rate limited through %s", req.Denom, req.ChannelId)
+	}
+
+	return &types.QueryRateLimitUsageResponse{Usage: k.rateLimitUsage(ctx, rateLimit)}, nil
+}
+
+// RateLimitUsages implements the Query/RateLimitUsages gRPC method.
+func (k Keeper) RateLimitUsages(c context.Context, req *types.QueryRateLimitUsagesRequest) (*types.QueryRateLimitUsagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := k.GetParams(ctx)
+	usages := make([]types.RateLimitUsage, 0, len(params.RateLimits))
+	for _, rateLimit := range params.RateLimits {
+		usages = append(usages, k.rateLimitUsage(ctx, rateLimit))
+	}
+
+	return &types.QueryRateLimitUsagesResponse{Usages: usages}, nil
+}
+
+func (k Keeper) rateLimitUsage(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimitUsage {
+	flow := k.CurrentFlow(ctx, rateLimit)
+
+	return types.RateLimitUsage{
+		RateLimit:    rateLimit,
+		Flow:         flow,
+		OutflowQuota: flow.OutflowQuota(rateLimit),
+		InflowQuota:  flow.InflowQuota(rateLimit),
+	}
+}
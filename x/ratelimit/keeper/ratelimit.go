@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/cosmos/gaia/v8/x/ratelimit/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// SendPacket implements the ICS4Wrapper interface. The outflow of a transfer
+// packet of a rate limited denom is checked against its quota and recorded
+// before the packet is sent.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	if err := k.sendTransfer(ctx, packet); err != nil {
+		return err
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// CurrentFlow returns the flow of a rate limit in the current window. Once
+// the window of the stored flow has ended, a new window starts with the
+// current supply of the denom.
+func (k Keeper) CurrentFlow(ctx sdk.Context, rateLimit types.RateLimit) types.Flow {
+	flow, found := k.GetFlow(ctx, rateLimit.ChannelId, rateLimit.Denom)
+	if found && ctx.BlockTime().Before(flow.WindowStart.Add(rateLimit.Window)) {
+		return flow
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, rateLimit.Denom)
+	return types.NewFlow(rateLimit.ChannelId, rateLimit.Denom, supply.Amount, ctx.BlockTime())
+}
+
+// sendTransfer records the outflow of a sent transfer packet, along with the
+// packet so that the outflow is refunded if the packet fails.
+func (k Keeper) sendTransfer(ctx sdk.Context, packet exported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil
+	}
+
+	// the denom of the packet is the full trace of the denom on the Hub
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	channelID := packet.GetSourceChannel()
+	rateLimit, found := k.GetParams(ctx).RateLimit(channelID, denom)
+	if !found {
+		return nil
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidAmount, "cannot parse %q", data.Amount)
+	}
+
+	flow := k.CurrentFlow(ctx, rateLimit)
+	if quota := flow.OutflowQuota(rateLimit); amount.GT(quota) {
+		emitQuotaExceeded(ctx, channelID, denom, types.DirectionOutflow, amount, quota)
+		return sdkerrors.Wrapf(types.ErrQuotaExceeded, "outflow of %s%s through %s exceeds the remaining quota of %s", amount, denom, channelID, quota)
+	}
+
+	flow.Outflow = flow.Outflow.Add(amount)
+	k.SetFlow(ctx, flow)
+	k.SetPendingSendPacket(ctx, types.PendingSendPacket{
+		ChannelId:   channelID,
+		Sequence:    packet.GetSequence(),
+		Denom:       denom,
+		Amount:      amount,
+		WindowStart: flow.WindowStart,
+	})
+
+	return nil
+}
+
+// ReceiveTransfer checks the inflow of a received transfer packet against its
+// quota and records it. The quota of a denom without supply is based on its
+// first inflow.
+func (k Keeper) ReceiveTransfer(ctx sdk.Context, packet exported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil
+	}
+
+	denom := receivedDenom(packet, data.Denom)
+	channelID := packet.GetDestChannel()
+	rateLimit, found := k.GetParams(ctx).RateLimit(channelID, denom)
+	if !found {
+		return nil
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidAmount, "cannot parse %q", data.Amount)
+	}
+
+	flow := k.CurrentFlow(ctx, rateLimit)
+	// a denom without supply, such as the vouchers of a counterparty denom
+	// received for the first time, has no quota to be based on: its first
+	// inflow is accepted and recorded as the supply of the window instead
+	if flow.Supply.IsZero() {
+		flow.Supply = amount
+		k.SetFlow(ctx, flow)
+		return nil
+	}
+	if quota := flow.InflowQuota(rateLimit); amount.GT(quota) {
+		emitQuotaExceeded(ctx, channelID, denom, types.DirectionInflow, amount, quota)
+		return sdkerrors.Wrapf(types.ErrQuotaExceeded, "inflow of %s%s through %s exceeds the remaining quota of %s", amount, denom, channelID, quota)
+	}
+
+	flow.Inflow = flow.Inflow.Add(amount)
+	k.SetFlow(ctx, flow)
+
+	return nil
+}
+
+// SettleSendPacket deletes the pending send packet of a sequence on a channel
+// once it is acknowledged or timed out. The outflow of a failed packet is
+// refunded, unless it was sent in a past window.
+func (k Keeper) SettleSendPacket(ctx sdk.Context, channelID string, sequence uint64, failed bool) {
+	pending, found := k.GetPendingSendPacket(ctx, channelID, sequence)
+	if !found {
+		return
+	}
+	k.DeletePendingSendPacket(ctx, channelID, sequence)
+
+	if !failed {
+		return
+	}
+	flow, found := k.GetFlow(ctx, channelID, pending.Denom)
+	if !found || !flow.WindowStart.Equal(pending.WindowStart) {
+		return
+	}
+
+	flow.Outflow = sdk.MaxInt(flow.Outflow.Sub(pending.Amount), sdk.ZeroInt())
+	k.SetFlow(ctx, flow)
+}
+
+// receivedDenom returns the denom on the Hub of the tokens received in a
+// transfer packet, as the transfer module computes it.
+func receivedDenom(packet exported.PacketI, packetDenom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), packetDenom) {
+		// the tokens return to the Hub, stripped of the prefix the Hub added
+		unprefixedDenom := packetDenom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+		return transfertypes.ParseDenomTrace(unprefixedDenom).IBCDenom()
+	}
+
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + packetDenom
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+func emitQuotaExceeded(ctx sdk.Context, channelID, denom, direction string, amount, quota sdk.Int) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRateLimitExceeded,
+		sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyDirection, direction),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyQuota, quota.String()),
+	))
+}
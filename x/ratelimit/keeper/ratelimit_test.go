@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v8/app/helpers"
+	"github.com/cosmos/gaia/v8/x/ratelimit/keeper"
+	"github.com/cosmos/gaia/v8/x/ratelimit/types"
+)
+
+// channelKeeper is an ICS4 wrapper recording the sent packets.
+type channelKeeper struct {
+	sent *[]exported.PacketI
+}
+
+func (ck channelKeeper) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, packet exported.PacketI) error {
+	*ck.sent = append(*ck.sent, packet)
+	return nil
+}
+
+func (channelKeeper) WriteAcknowledgement(sdk.Context, *capabilitytypes.Capability, exported.PacketI, exported.Acknowledgement) error {
+	return nil
+}
+
+func transferPacket(sequence uint64, srcChannel, dstChannel, denom string, amount sdk.Int) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, amount.String(), "sender", "receiver")
+	return channeltypes.NewPacket(data.GetBytes(), sequence, transfertypes.PortID, srcChannel, transfertypes.PortID, dstChannel, clienttypes.NewHeight(0, 100), 0)
+}
+
+func TestRateLimit(t *testing.T) {
+	app := gaiahelpers.Setup(t, false, 0)
+	start := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: start})
+	var sent []exported.PacketI
+	k := keeper.NewKeeper(app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.BankKeeper, channelKeeper{&sent})
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	supply := app.BankKeeper.GetSupply(ctx, bondDenom).Amount
+
+	// transfers are not limited by default
+	require.NoError(t, k.SendPacket(ctx, nil, transferPacket(1, "channel-0", "channel-7", bondDenom, supply)))
+	require.Len(t, sent, 1)
+
+	rateLimit := types.NewRateLimit("channel-0", bondDenom, sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(5, 2), time.Hour)
+	k.SetParams(ctx, types.NewParams([]types.RateLimit{rateLimit}))
+	outflowQuota := supply.QuoRaw(10)
+	inflowQuota := supply.QuoRaw(20)
+
+	// outflows are limited to their quota, and not limited through other
+	// channels
+	require.NoError(t, k.SendPacket(ctx, nil, transferPacket(2, "channel-0", "channel-7", bondDenom, outflowQuota.SubRaw(1))))
+	require.NoError(t, k.SendPacket(ctx, nil, transferPacket(3, "channel-1", "channel-8", bondDenom, outflowQuota)))
+	err := k.SendPacket(ctx, nil, transferPacket(4, "channel-0", "channel-7", bondDenom, sdk.NewInt(2)))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+	require.Len(t, sent, 3)
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeRateLimitExceeded, events[len(events)-1].Type)
+
+	flow := k.CurrentFlow(ctx, rateLimit)
+	require.Equal(t, outflowQuota.SubRaw(1), flow.Outflow)
+	require.Equal(t, sdk.OneInt(), flow.OutflowQuota(rateLimit))
+
+	// the inflows of the returning tokens are netted against the outflows
+	returning := "transfer/channel-7/" + bondDenom
+	require.NoError(t, k.ReceiveTransfer(ctx, transferPacket(1, "channel-7", "channel-0", returning, outflowQuota.SubRaw(1))))
+	require.NoError(t, k.ReceiveTransfer(ctx, transferPacket(2, "channel-7", "channel-0", returning, inflowQuota)))
+	err = k.ReceiveTransfer(ctx, transferPacket(3, "channel-7", "channel-0", returning, sdk.OneInt()))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+
+	// the tokens of the counterparty chain are not limited
+	require.NoError(t, k.ReceiveTransfer(ctx, transferPacket(4, "channel-7", "channel-0", bondDenom, supply)))
+
+	// the outflows of the failed packets are refunded, unlike those of the
+	// acknowledged ones
+	k.SettleSendPacket(ctx, "channel-0", 2, true)
+	flow = k.CurrentFlow(ctx, rateLimit)
+	require.True(t, flow.Outflow.IsZero())
+	require.Equal(t, outflowQuota.Add(flow.Inflow), flow.OutflowQuota(rateLimit))
+	_, found := k.GetPendingSendPacket(ctx, "channel-0", 2)
+	require.False(t, found)
+
+	// a new window starts once the window ends, and the failed packets sent
+	// in past windows are not refunded
+	require.NoError(t, k.SendPacket(ctx, nil, transferPacket(5, "channel-0", "channel-7", bondDenom, outflowQuota)))
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	flow = k.CurrentFlow(ctx, rateLimit)
+	require.Equal(t, start.Add(time.Hour), flow.WindowStart)
+	require.Equal(t, outflowQuota, flow.OutflowQuota(rateLimit))
+	require.Equal(t, inflowQuota, flow.InflowQuota(rateLimit))
+
+	require.NoError(t, k.SendPacket(ctx, nil, transferPacket(6, "channel-0", "channel-7", bondDenom, sdk.NewInt(10))))
+	k.SettleSendPacket(ctx, "channel-0", 5, true)
+	require.Equal(t, sdk.NewInt(10), k.CurrentFlow(ctx, rateLimit).Outflow)
+
+	// the flows and pending packets are exported
+	genState := k.ExportGenesis(ctx)
+	require.NoError(t, genState.Validate())
+	require.Len(t, genState.Flows, 1)
+	require.Len(t, genState.PendingSendPackets, 1)
+	require.Equal(t, uint64(6), genState.PendingSendPackets[0].Sequence)
+}
+
+func TestRateLimitWithoutSupply(t *testing.T) {
+	app := gaiahelpers.Setup(t, false, 0)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)})
+	k := keeper.NewKeeper(app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.BankKeeper, channelKeeper{&[]exported.PacketI{}})
+
+	// the vouchers of a counterparty denom which was never received
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-0/uosmo").IBCDenom()
+	require.True(t, app.BankKeeper.GetSupply(ctx, voucher).Amount.IsZero())
+	rateLimit := types.NewRateLimit("channel-0", voucher, sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(5, 2), time.Hour)
+	k.SetParams(ctx, types.NewParams([]types.RateLimit{rateLimit}))
+
+	// the first inflow is accepted, and the quota of the window is based on it
+	require.NoError(t, k.ReceiveTransfer(ctx, transferPacket(1, "channel-7", "channel-0", "uosmo", sdk.NewInt(1_000_000))))
+	flow := k.CurrentFlow(ctx, rateLimit)
+	require.Equal(t, sdk.NewInt(1_000_000), flow.Supply)
+	require.Equal(t, sdk.NewInt(50_000), flow.InflowQuota(rateLimit))
+
+	require.NoError(t, k.ReceiveTransfer(ctx, transferPacket(2, "channel-7", "channel-0", "uosmo", sdk.NewInt(50_000))))
+	err := k.ReceiveTransfer(ctx, transferPacket(3, "channel-7", "channel-0", "uosmo", sdk.OneInt()))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+}
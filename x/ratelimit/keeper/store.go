@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/ratelimit/types"
+)
+
+// SetFlow sets the flow of the rate limit of a denom through a channel.
+func (k Keeper) SetFlow(ctx sdk.Context, flow types.Flow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FlowKey(flow.ChannelId, flow.Denom), k.cdc.MustMarshal(&flow))
+}
+
+// GetFlow returns the flow of the rate limit of a denom through a channel.
+func (k Keeper) GetFlow(ctx sdk.Context, channelID, denom string) (flow types.Flow, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FlowKey(channelID, denom))
+	if bz == nil {
+		return flow, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow, true
+}
+
+// GetAllFlows returns the flows of all the rate limits.
+func (k Keeper) GetAllFlows(ctx sdk.Context) []types.Flow {
+	var flows []types.Flow
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FlowKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var flow types.Flow
+		k.cdc.MustUnmarshal(iterator.Value(), &flow)
+		flows = append(flows, flow)
+	}
+
+	return flows
+}
+
+// SetPendingSendPacket sets a pending send packet.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingSendPacketKey(packet.ChannelId, packet.Sequence), k.cdc.MustMarshal(&packet))
+}
+
+// GetPendingSendPacket returns the pending send packet of a sequence on a
+// channel.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (packet types.PendingSendPacket, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingSendPacketKey(channelID, sequence))
+	if bz == nil {
+		return packet, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// DeletePendingSendPacket deletes the pending send packet of a sequence on a
+// channel.
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingSendPacketKey(channelID, sequence))
+}
+
+// GetAllPendingSendPackets returns all the pending send packets.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	var packets []types.PendingSendPacket
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingSendPacketKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
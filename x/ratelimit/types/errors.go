@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/ratelimit errors
+var (
+	ErrQuotaExceeded = sdkerrors.Register(ModuleName, 2, "rate limit quota exceeded")
+	ErrInvalidAmount = sdkerrors.Register(ModuleName, 3, "invalid transfer amount")
+)
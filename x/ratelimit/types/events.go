@@ -0,0 +1,15 @@
+package types
+
+// ratelimit module event types and attributes
+const (
+	EventTypeRateLimitExceeded = "rate_limit_exceeded"
+
+	AttributeKeyChannel   = "channel"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyDirection = "direction"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyQuota     = "quota"
+
+	DirectionOutflow = "outflow"
+	DirectionInflow  = "inflow"
+)
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// BankKeeper defines the expected bank keeper.
+type BankKeeper interface {
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+}
+
+// ICS4Wrapper defines the expected ICS4 wrapper the rate limited packets are
+// sent and acknowledged through, usually the channel keeper.
+type ICS4Wrapper interface {
+	SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet exported.PacketI) error
+	WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, acknowledgement exported.Acknowledgement) error
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFlow creates a new Flow instance, with no inflow nor outflow yet.
+func NewFlow(channelID, denom string, supply sdk.Int, windowStart time.Time) Flow {
+	return Flow{
+		ChannelId:   channelID,
+		Denom:       denom,
+		Inflow:      sdk.ZeroInt(),
+		Outflow:     sdk.ZeroInt(),
+		Supply:      supply,
+		WindowStart: windowStart,
+	}
+}
+
+// OutflowQuota returns the amount which can still be sent within the window
+// of the flow under a rate limit.
+func (f Flow) OutflowQuota(rateLimit RateLimit) sdk.Int {
+	return quota(rateLimit.MaxOutflow, f.Supply, f.Outflow.Sub(f.Inflow))
+}
+
+// InflowQuota returns the amount which can still be received within the
+// window of the flow under a rate limit.
+func (f Flow) InflowQuota(rateLimit RateLimit) sdk.Int {
+	return quota(rateLimit.MaxInflow, f.Supply, f.Inflow.Sub(f.Outflow))
+}
+
+func quota(maxFlow sdk.Dec, supply, netFlow sdk.Int) sdk.Int {
+	remaining := maxFlow.MulInt(supply).TruncateInt().Sub(netFlow)
+	if remaining.IsNegative() {
+		return sdk.ZeroInt()
+	}
+
+	return remaining
+}
+
+// Validate validates the flow.
+func (f Flow) Validate() error {
+	for name, amount := range map[string]sdk.Int{"inflow": f.Inflow, "outflow": f.Outflow, "supply": f.Supply} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("%s must not be negative: %s", name, amount)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState instance.
+func NewGenesisState(params Params, flows []Flow, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		Params:             params,
+		Flows:              flows,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the ratelimit
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	flows := make(map[string]bool, len(gs.Flows))
+	for _, flow := range gs.Flows {
+		if _, found := gs.Params.RateLimit(flow.ChannelId, flow.Denom); !found {
+			return fmt.Errorf("flow of %s through %s, which is not rate limited", flow.Denom, flow.ChannelId)
+		}
+		key := string(FlowKey(flow.ChannelId, flow.Denom))
+		if flows[key] {
+			return fmt.Errorf("duplicate flow of %s through %s", flow.Denom, flow.ChannelId)
+		}
+		flows[key] = true
+
+		if err := flow.Validate(); err != nil {
+			return fmt.Errorf("invalid flow of %s through %s: %w", flow.Denom, flow.ChannelId, err)
+		}
+	}
+
+	packets := make(map[string]bool, len(gs.PendingSendPackets))
+	for _, packet := range gs.PendingSendPackets {
+		key := string(PendingSendPacketKey(packet.ChannelId, packet.Sequence))
+		if packets[key] {
+			return fmt.Errorf("duplicate pending send packet %d on %s", packet.Sequence, packet.ChannelId)
+		}
+		packets[key] = true
+
+		if packet.Amount.IsNil() || !packet.Amount.IsPositive() {
+			return fmt.Errorf("non positive amount of pending send packet %d on %s", packet.Sequence, packet.ChannelId)
+		}
+	}
+
+	return nil
+}
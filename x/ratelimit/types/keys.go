@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the name of the ratelimit module.
+	ModuleName = "ratelimit"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// FlowKeyPrefix prefixes the flows of the rate limits, by channel and
+	// denom.
+	FlowKeyPrefix = []byte{0x01}
+
+	// PendingSendPacketKeyPrefix prefixes the pending send packets, by
+	// channel and sequence.
+	PendingSendPacketKeyPrefix = []byte{0x02}
+)
+
+// FlowKey returns the key of the flow of the rate limit of a denom through a
+// channel.
+func FlowKey(channelID, denom string) []byte {
+	key := append(FlowKeyPrefix, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// PendingSendPacketKey returns the key of the pending send packet of a
+// sequence on a channel.
+func PendingSendPacketKey(channelID string, sequence uint64) []byte {
+	key := append(PendingSendPacketKeyPrefix, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
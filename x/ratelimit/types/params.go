@@ -0,0 +1,122 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"sigs.k8s.io/yaml"
+)
+
+// Parameter store keys
+var (
+	KeyRateLimits = []byte("RateLimits")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table of the ratelimit module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(rateLimits []RateLimit) Params {
+	return Params{
+		RateLimits: rateLimits,
+	}
+}
+
+// DefaultParams returns the default parameters of the ratelimit module, which
+// limit no transfer.
+func DefaultParams() Params {
+	return NewParams(nil)
+}
+
+// NewRateLimit creates a new RateLimit instance.
+func NewRateLimit(channelID, denom string, maxOutflow, maxInflow sdk.Dec, window time.Duration) RateLimit {
+	return RateLimit{
+		ChannelId:  channelID,
+		Denom:      denom,
+		MaxOutflow: maxOutflow,
+		MaxInflow:  maxInflow,
+		Window:     window,
+	}
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRateLimits, &p.RateLimits, validateRateLimits),
+	}
+}
+
+// Validate validates the parameters.
+func (p Params) Validate() error {
+	if err := validateRateLimits(p.RateLimits); err != nil {
+		return fmt.Errorf("invalid rate limits: %w", err)
+	}
+
+	return nil
+}
+
+// RateLimit returns the rate limit of a denom through a channel, if any.
+func (p Params) RateLimit(channelID, denom string) (RateLimit, bool) {
+	for _, rateLimit := range p.RateLimits {
+		if rateLimit.ChannelId == channelID && rateLimit.Denom == denom {
+			return rateLimit, true
+		}
+	}
+
+	return RateLimit{}, false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate validates the rate limit.
+func (rl RateLimit) Validate() error {
+	if err := host.ChannelIdentifierValidator(rl.ChannelId); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(rl.Denom); err != nil {
+		return err
+	}
+	if rl.MaxOutflow.IsNil() || rl.MaxOutflow.IsNegative() {
+		return fmt.Errorf("max outflow must not be negative: %s", rl.MaxOutflow)
+	}
+	if rl.MaxInflow.IsNil() || rl.MaxInflow.IsNegative() {
+		return fmt.Errorf("max inflow must not be negative: %s", rl.MaxInflow)
+	}
+	if rl.Window <= 0 {
+		return fmt.Errorf("window must be positive: %s", rl.Window)
+	}
+
+	return nil
+}
+
+func validateRateLimits(i interface{}) error {
+	v, ok := i.([]RateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	rateLimits := make(map[string]bool, len(v))
+	for _, rateLimit := range v {
+		if err := rateLimit.Validate(); err != nil {
+			return fmt.Errorf("rate limit of %s through %s: %w", rateLimit.Denom, rateLimit.ChannelId, err)
+		}
+		key := string(FlowKey(rateLimit.ChannelId, rateLimit.Denom))
+		if rateLimits[key] {
+			return fmt.Errorf("duplicate rate limit of %s through %s", rateLimit.Denom, rateLimit.ChannelId)
+		}
+		rateLimits[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/recovery/types"
+)
+
+// GetQueryCmd returns the cli query commands for the recovery module.
+func GetQueryCmd() *cobra.Command {
+	recoveryQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the recovery module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	recoveryQueryCmd.AddCommand(
+		GetCmdQueryRecoverableEscrow(),
+	)
+
+	return recoveryQueryCmd
+}
+
+// GetCmdQueryRecoverableEscrow implements the recoverable escrow query
+// command.
+func GetCmdQueryRecoverableEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recoverable-escrow [channel-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the in-flight transfers a recover escrow proposal would refund",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query, as a dry run of a recover escrow proposal, the in-flight transfer
+packets of a channel whose escrowed tokens the proposal would return to their
+senders, along with whether the channel is recoverable.
+
+Example:
+$ %s query %s recoverable-escrow channel-0
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RecoverableEscrow(cmd.Context(), &types.QueryRecoverableEscrowRequest{ChannelId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
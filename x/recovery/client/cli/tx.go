@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/recovery/types"
+)
+
+// NewCmdSubmitRecoverEscrowProposal implements the command submitting a
+// recover escrow proposal.
+func NewCmdSubmitRecoverEscrowProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recover-escrow [channel-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal returning the escrowed tokens of the in-flight transfers of a channel",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal returning the escrowed tokens of the in-flight transfer
+packets of a channel to their senders, along with an initial deposit. The
+channel must be closed or its counterparty client must not be active anymore,
+for instance once it expired and could not be substituted. The packets of an
+open channel which have not timed out yet are not refunded.
+
+The refunded packets can be listed beforehand with:
+$ %[1]s query recovery recoverable-escrow [channel-id]
+
+Example:
+$ %[1]s tx gov submit-legacy-proposal recover-escrow channel-0 --title="..." --description="..." --deposit=10000000uatom --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRecoverEscrowProposal(title, description, args[0])
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/cosmos/gaia/v8/x/recovery/client/cli"
+)
+
+// RecoverEscrowProposalHandler is the recover escrow proposal command
+// handler.
+var RecoverEscrowProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRecoverEscrowProposal)
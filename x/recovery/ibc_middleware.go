@@ -0,0 +1,71 @@
+package recovery
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+
+	"github.com/cosmos/gaia/v8/x/recovery/keeper"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the transfer IBC module to settle the in-flight packets
+// once they are acknowledged or timed out. The packets are recorded when they
+// are sent, by the keeper wrapping the ICS4 wrapper of the transfer keeper.
+// All the other callbacks are passed through to the wrapped module.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the given transfer
+// IBC module.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The
+// acknowledgement of a packet whose escrowed tokens were returned by
+// governance is not passed to the transfer module, which would refund them
+// again on an error acknowledgement.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if !im.keeper.SettlePacket(ctx, packet.SourceChannel, packet.Sequence) {
+		return im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil && ack.Success() {
+		// the tokens were both received on the counterparty chain and
+		// returned to the sender, the escrow account is short of them
+		im.keeper.Logger(ctx).Error("recovered packet was received by the counterparty chain",
+			"channel", packet.SourceChannel, "sequence", packet.Sequence)
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The timeout of a packet
+// whose escrowed tokens were returned by governance is not passed to the
+// transfer module, which would refund them again.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if !im.keeper.SettlePacket(ctx, packet.SourceChannel, packet.Sequence) {
+		return im.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	return nil
+}
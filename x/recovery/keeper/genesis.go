@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/recovery/types"
+)
+
+// InitGenesis initializes the recovery module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	for _, packet := range genState.InFlightPackets {
+		k.SetInFlightPacket(ctx, packet)
+	}
+	for _, packet := range genState.RecoveredPackets {
+		k.SetRecoveredPacket(ctx, packet)
+	}
+}
+
+// ExportGenesis returns the recovery module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllInFlightPackets(ctx), k.GetAllRecoveredPackets(ctx))
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/gaia/v8/x/recovery/types"
+)
+
+// Keeper of the recovery store.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	bankKeeper    types.BankKeeper
+	channelKeeper types.ChannelKeeper
+	clientKeeper  types.ClientKeeper
+	ics4Wrapper   types.ICS4Wrapper
+}
+
+// NewKeeper creates a new recovery Keeper instance. The in-flight packets
+// are recorded as they are sent through the ICS4 wrapper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	channelKeeper types.ChannelKeeper,
+	clientKeeper types.ClientKeeper,
+	ics4Wrapper types.ICS4Wrapper,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		bankKeeper:    bankKeeper,
+		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
+		ics4Wrapper:   ics4Wrapper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/cosmos/gaia/v8/x/recovery/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// SendPacket implements the ICS4Wrapper interface. A transfer packet whose
+// tokens are escrowed on the Hub is recorded as in flight once it is sent.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	if err := k.ics4Wrapper.SendPacket(ctx, chanCap, packet); err != nil {
+		return err
+	}
+
+	k.recordPacket(ctx, packet)
+
+	return nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// recordPacket records a sent transfer packet as in flight, unless its tokens
+// were burnt rather than escrowed, being vouchers returning to their source
+// chain.
+func (k Keeper) recordPacket(ctx sdk.Context, packet exported.PacketI) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+	if !transfertypes.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		return
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return
+	}
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return
+	}
+
+	// the denom of the packet is the full trace of the denom on the Hub
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	timeoutHeight := clienttypes.NewHeight(packet.GetTimeoutHeight().GetRevisionNumber(), packet.GetTimeoutHeight().GetRevisionHeight())
+	k.SetInFlightPacket(ctx, types.NewInFlightPacket(
+		packet.GetSourceChannel(),
+		packet.GetSequence(),
+		sender,
+		sdk.NewCoin(denom, amount),
+		timeoutHeight,
+		packet.GetTimeoutTimestamp(),
+	))
+}
+
+// SettlePacket deletes the record of a packet of a sequence on a channel once
+// it is acknowledged or timed out. It returns whether the escrowed tokens of
+// the packet were already returned to its sender by governance, in which
+// case the transfer module must not refund them again.
+func (k Keeper) SettlePacket(ctx sdk.Context, channelID string, sequence uint64) (recovered bool) {
+	k.DeleteInFlightPacket(ctx, channelID, sequence)
+
+	if _, found := k.GetRecoveredPacket(ctx, channelID, sequence); !found {
+		return false
+	}
+	k.DeleteRecoveredPacket(ctx, channelID, sequence)
+
+	return true
+}
+
+// RecoverEscrow returns the escrowed tokens of the in-flight packets of a
+// channel to their senders, as a recover escrow proposal passes. The channel
+// must be closed or its counterparty client must not be active anymore, so
+// that the packets can neither be acknowledged nor timed out. The packets of
+// an open channel which have not timed out yet are skipped, since the
+// counterparty chain could still receive them.
+func (k Keeper) RecoverEscrow(ctx sdk.Context, p *types.RecoverEscrowProposal) error {
+	res, err := k.recoverableEscrow(ctx, p.ChannelId)
+	if err != nil {
+		return err
+	}
+	if !res.Recoverable {
+		return sdkerrors.Wrapf(types.ErrNotRecoverable, "channel %s is %s and its client is %s", p.ChannelId, res.ChannelState, res.ClientStatus)
+	}
+	if len(res.Packets) == 0 {
+		return sdkerrors.Wrapf(types.ErrNoInFlightPackets, "channel %s", p.ChannelId)
+	}
+
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, p.ChannelId)
+	for _, packet := range res.Packets {
+		sender, err := sdk.AccAddressFromBech32(packet.Sender)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, sender, sdk.NewCoins(packet.Amount)); err != nil {
+			return sdkerrors.Wrapf(err, "failed to return the escrow of packet %d", packet.Sequence)
+		}
+
+		k.DeleteInFlightPacket(ctx, packet.ChannelId, packet.Sequence)
+		k.SetRecoveredPacket(ctx, packet)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRecoverEscrow,
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprint(packet.Sequence)),
+			sdk.NewAttribute(types.AttributeKeySender, packet.Sender),
+			sdk.NewAttribute(types.AttributeKeyAmount, packet.Amount.String()),
+		))
+	}
+
+	k.Logger(ctx).Info("recovered escrowed tokens", "channel", p.ChannelId, "packets", len(res.Packets), "total", res.Total)
+
+	return nil
+}
+
+// recoverableEscrow returns the in-flight packets of a channel whose escrowed
+// tokens a recover escrow proposal would return to their senders, along with
+// whether the channel is recoverable.
+func (k Keeper) recoverableEscrow(ctx sdk.Context, channelID string) (*types.QueryRecoverableEscrowResponse, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, channelID)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port %s, channel %s", transfertypes.PortID, channelID)
+	}
+	clientID, clientState, err := k.channelKeeper.GetChannelClientState(ctx, transfertypes.PortID, channelID)
+	if err != nil {
+		return nil, err
+	}
+	clientStatus := clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc)
+
+	res := &types.QueryRecoverableEscrowResponse{
+		ChannelState: channel.State.String(),
+		ClientStatus: clientStatus.String(),
+		Recoverable:  channel.State == channeltypes.CLOSED || clientStatus != exported.Active,
+		Total:        sdk.NewCoins(),
+	}
+	for _, packet := range k.GetInFlightPacketsByChannel(ctx, channelID) {
+		// the packets whose commitment was deleted were settled already
+		if k.channelKeeper.GetPacketCommitment(ctx, transfertypes.PortID, channelID, packet.Sequence) == nil {
+			continue
+		}
+
+		// a closed channel cannot receive packets on the counterparty chain
+		if channel.State != channeltypes.CLOSED && !packet.TimedOut(clientState.GetLatestHeight(), ctx.BlockTime()) {
+			res.PendingPackets = append(res.PendingPackets, packet)
+			continue
+		}
+
+		res.Packets = append(res.Packets, packet)
+		res.Total = res.Total.Add(packet.Amount)
+	}
+
+	return res, nil
+}
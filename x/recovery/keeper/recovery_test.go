@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v8/app/helpers"
+	"github.com/cosmos/gaia/v8/x/recovery/keeper"
+	"github.com/cosmos/gaia/v8/x/recovery/types"
+)
+
+const (
+	clientID     = "07-tendermint-0"
+	connectionID = "connection-0"
+	channelID    = "channel-0"
+)
+
+// ics4Wrapper is an ICS4 wrapper sending the packets nowhere.
+type ics4Wrapper struct{}
+
+func (ics4Wrapper) SendPacket(sdk.Context, *capabilitytypes.Capability, exported.PacketI) error {
+	return nil
+}
+
+func (ics4Wrapper) WriteAcknowledgement(sdk.Context, *capabilitytypes.Capability, exported.PacketI, exported.Acknowledgement) error {
+	return nil
+}
+
+func transferPacket(sequence uint64, denom string, amount int64, sender sdk.AccAddress, timeoutTimestamp time.Time) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, sdk.NewInt(amount).String(), sender.String(), "receiver")
+	return channeltypes.NewPacket(data.GetBytes(), sequence, transfertypes.PortID, channelID, transfertypes.PortID, "channel-7", clienttypes.ZeroHeight(), uint64(timeoutTimestamp.UnixNano()))
+}
+
+func TestRecoverEscrow(t *testing.T) {
+	app := gaiahelpers.Setup(t, false, 0)
+	start := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: start})
+	k := keeper.NewKeeper(app.AppCodec(), app.GetKey(types.StoreKey), app.BankKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ClientKeeper, ics4Wrapper{})
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// a transfer channel whose client expires after an hour without update
+	clientState := ibctmtypes.NewClientState("counterparty", ibctmtypes.DefaultTrustLevel, time.Hour, 2*time.Hour, time.Minute,
+		clienttypes.NewHeight(0, 100), commitmenttypes.GetSDKSpecs(), nil, false, false)
+	app.IBCKeeper.ClientKeeper.SetClientState(ctx, clientID, clientState)
+	app.IBCKeeper.ClientKeeper.SetClientConsensusState(ctx, clientID, clientState.GetLatestHeight(),
+		ibctmtypes.NewConsensusState(start, commitmenttypes.NewMerkleRoot([]byte("root")), []byte("hash")))
+	app.IBCKeeper.ConnectionKeeper.SetConnection(ctx, connectionID, connectiontypes.NewConnectionEnd(connectiontypes.OPEN, clientID,
+		connectiontypes.NewCounterparty(clientID, connectionID, commitmenttypes.NewMerklePrefix([]byte("ibc"))), nil, 0))
+	channel := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(transfertypes.PortID, "channel-7"),
+		[]string{connectionID}, transfertypes.Version)
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, channelID, channel)
+
+	// the escrowed transfers are recorded, unlike the burnt vouchers
+	sender := sdk.AccAddress("sender______________")
+	packets := []channeltypes.Packet{
+		transferPacket(1, bondDenom, 100, sender, start.Add(10*time.Minute)),
+		transferPacket(2, "transfer/channel-0/uosmo", 100, sender, start.Add(10*time.Minute)),
+		transferPacket(3, bondDenom, 50, sender, start.Add(2*time.Hour)),
+		transferPacket(4, bondDenom, 10, sender, start.Add(10*time.Minute)),
+	}
+	for _, packet := range packets {
+		require.NoError(t, k.SendPacket(ctx, nil, packet))
+	}
+	// the commitment of the last packet is deleted as it is acknowledged,
+	// before the acknowledgement reaches the transfer stack
+	for _, sequence := range []uint64{1, 2, 3} {
+		app.IBCKeeper.ChannelKeeper.SetPacketCommitment(ctx, transfertypes.PortID, channelID, sequence, []byte("commitment"))
+	}
+	require.Len(t, k.GetAllInFlightPackets(ctx), 3)
+	escrow := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 160))
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, channelID)
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, escrow))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, escrowAddress, escrow))
+
+	// the escrow cannot be recovered while the client is active
+	proposal := types.NewRecoverEscrowProposal("title", "description", channelID).(*types.RecoverEscrowProposal)
+	ctx = ctx.WithBlockTime(start.Add(30 * time.Minute))
+	res, err := k.RecoverableEscrow(sdk.WrapSDKContext(ctx), &types.QueryRecoverableEscrowRequest{ChannelId: channelID})
+	require.NoError(t, err)
+	require.False(t, res.Recoverable)
+	require.Equal(t, exported.Active.String(), res.ClientStatus)
+	require.ErrorIs(t, k.RecoverEscrow(ctx, proposal), types.ErrNotRecoverable)
+
+	// once it expired, only the packets which timed out are recovered
+	ctx = ctx.WithBlockTime(start.Add(90 * time.Minute))
+	res, err = k.RecoverableEscrow(sdk.WrapSDKContext(ctx), &types.QueryRecoverableEscrowRequest{ChannelId: channelID})
+	require.NoError(t, err)
+	require.True(t, res.Recoverable)
+	require.Equal(t, exported.Expired.String(), res.ClientStatus)
+	require.Len(t, res.Packets, 1)
+	require.Equal(t, uint64(1), res.Packets[0].Sequence)
+	require.Len(t, res.PendingPackets, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), res.Total)
+
+	require.NoError(t, k.RecoverEscrow(ctx, proposal))
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 100), app.BankKeeper.GetBalance(ctx, sender, bondDenom))
+	_, found := k.GetRecoveredPacket(ctx, channelID, 1)
+	require.True(t, found)
+
+	// the pending packets are recovered once the channel is closed
+	channel.State = channeltypes.CLOSED
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, channelID, channel)
+	require.NoError(t, k.RecoverEscrow(ctx, proposal))
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 150), app.BankKeeper.GetBalance(ctx, sender, bondDenom))
+	require.ErrorIs(t, k.RecoverEscrow(ctx, proposal), types.ErrNoInFlightPackets)
+
+	// a recovered packet which is timed out later is not refunded again,
+	// unlike the packets which were not recovered
+	require.True(t, k.SettlePacket(ctx, channelID, 1))
+	require.False(t, k.SettlePacket(ctx, channelID, 4))
+	_, found = k.GetRecoveredPacket(ctx, channelID, 1)
+	require.False(t, found)
+
+	genState := k.ExportGenesis(ctx)
+	require.NoError(t, genState.Validate())
+	require.Empty(t, genState.InFlightPackets)
+	require.Len(t, genState.RecoveredPackets, 1)
+}
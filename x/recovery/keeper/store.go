@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/recovery/types"
+)
+
+// SetInFlightPacket sets an in-flight packet.
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, packet types.InFlightPacket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.InFlightPacketKey(packet.ChannelId, packet.Sequence), k.cdc.MustMarshal(&packet))
+}
+
+// GetInFlightPacket returns the in-flight packet of a sequence on a channel.
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, channelID string, sequence uint64) (packet types.InFlightPacket, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.InFlightPacketKey(channelID, sequence))
+	if bz == nil {
+		return packet, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// DeleteInFlightPacket deletes the in-flight packet of a sequence on a
+// channel.
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.InFlightPacketKey(channelID, sequence))
+}
+
+// GetInFlightPacketsByChannel returns the in-flight packets of a channel, in
+// the order of their sequences.
+func (k Keeper) GetInFlightPacketsByChannel(ctx sdk.Context, channelID string) []types.InFlightPacket {
+	return k.getPackets(ctx, types.InFlightPacketsByChannelKey(channelID))
+}
+
+// GetAllInFlightPackets returns all the in-flight packets.
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	return k.getPackets(ctx, types.InFlightPacketKeyPrefix)
+}
+
+// SetRecoveredPacket sets a recovered packet.
+func (k Keeper) SetRecoveredPacket(ctx sdk.Context, packet types.InFlightPacket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RecoveredPacketKey(packet.ChannelId, packet.Sequence), k.cdc.MustMarshal(&packet))
+}
+
+// GetRecoveredPacket returns the recovered packet of a sequence on a channel.
+func (k Keeper) GetRecoveredPacket(ctx sdk.Context, channelID string, sequence uint64) (packet types.InFlightPacket, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RecoveredPacketKey(channelID, sequence))
+	if bz == nil {
+		return packet, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// DeleteRecoveredPacket deletes the recovered packet of a sequence on a
+// channel.
+func (k Keeper) DeleteRecoveredPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RecoveredPacketKey(channelID, sequence))
+}
+
+// GetAllRecoveredPackets returns all the recovered packets.
+func (k Keeper) GetAllRecoveredPackets(ctx sdk.Context) []types.InFlightPacket {
+	return k.getPackets(ctx, types.RecoveredPacketKeyPrefix)
+}
+
+func (k Keeper) getPackets(ctx sdk.Context, prefix []byte) []types.InFlightPacket {
+	var packets []types.InFlightPacket
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.InFlightPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
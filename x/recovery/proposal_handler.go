@@ -0,0 +1,24 @@
+package recovery
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos/gaia/v8/x/recovery/keeper"
+	"github.com/cosmos/gaia/v8/x/recovery/types"
+)
+
+// NewRecoverEscrowProposalHandler creates a governance handler executing the
+// recover escrow proposals.
+func NewRecoverEscrowProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.RecoverEscrowProposal:
+			return k.RecoverEscrow(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized recovery proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// RegisterLegacyAminoCodec registers the x/recovery proposal on the provided
+// LegacyAmino codec, for Amino JSON signing.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&RecoverEscrowProposal{}, "gaia/RecoverEscrowProposal", nil)
+}
+
+// RegisterInterfaces registers the x/recovery proposal with the interface
+// registry.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&RecoverEscrowProposal{},
+	)
+}
+
+func init() {
+	// register the proposal on the global Amino codec, so that the submit
+	// proposal messages carrying it can be signed with Amino JSON
+	RegisterLegacyAminoCodec(legacy.Cdc)
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/recovery errors
+var (
+	ErrInvalidProposal   = sdkerrors.Register(ModuleName, 2, "invalid recover escrow proposal")
+	ErrNotRecoverable    = sdkerrors.Register(ModuleName, 3, "channel is not recoverable")
+	ErrNoInFlightPackets = sdkerrors.Register(ModuleName, 4, "no recoverable in-flight packets")
+)
@@ -0,0 +1,11 @@
+package types
+
+// recovery module event types and attributes
+const (
+	EventTypeRecoverEscrow = "recover_escrow"
+
+	AttributeKeyChannel  = "channel"
+	AttributeKeySequence = "sequence"
+	AttributeKeySender   = "sender"
+	AttributeKeyAmount   = "amount"
+)
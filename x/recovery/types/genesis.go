@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState instance.
+func NewGenesisState(inFlightPackets, recoveredPackets []InFlightPacket) *GenesisState {
+	return &GenesisState{
+		InFlightPackets:  inFlightPackets,
+		RecoveredPackets: recoveredPackets,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the recovery
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(nil, nil)
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := validatePackets(gs.InFlightPackets, "in-flight"); err != nil {
+		return err
+	}
+
+	return validatePackets(gs.RecoveredPackets, "recovered")
+}
+
+func validatePackets(packets []InFlightPacket, kind string) error {
+	seen := make(map[string]bool, len(packets))
+	for _, packet := range packets {
+		key := string(InFlightPacketKey(packet.ChannelId, packet.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate %s packet %d on %s", kind, packet.Sequence, packet.ChannelId)
+		}
+		seen[key] = true
+
+		if err := packet.Validate(); err != nil {
+			return fmt.Errorf("invalid %s packet %d on %s: %w", kind, packet.Sequence, packet.ChannelId, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the name of the recovery module.
+	ModuleName = "recovery"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey defines the governance router key of the module.
+	RouterKey = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// InFlightPacketKeyPrefix prefixes the in-flight packets, by channel and
+	// sequence.
+	InFlightPacketKeyPrefix = []byte{0x01}
+
+	// RecoveredPacketKeyPrefix prefixes the recovered packets, by channel and
+	// sequence.
+	RecoveredPacketKeyPrefix = []byte{0x02}
+)
+
+// InFlightPacketKey returns the key of the in-flight packet of a sequence on a
+// channel.
+func InFlightPacketKey(channelID string, sequence uint64) []byte {
+	return append(InFlightPacketsByChannelKey(channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// InFlightPacketsByChannelKey returns the key prefix of the in-flight packets
+// of a channel.
+func InFlightPacketsByChannelKey(channelID string) []byte {
+	return append(InFlightPacketKeyPrefix, address.MustLengthPrefix([]byte(channelID))...)
+}
+
+// RecoveredPacketKey returns the key of the recovered packet of a sequence on
+// a channel.
+func RecoveredPacketKey(channelID string, sequence uint64) []byte {
+	key := append(RecoveredPacketKeyPrefix, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
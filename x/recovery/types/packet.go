@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// NewInFlightPacket creates a new InFlightPacket instance.
+func NewInFlightPacket(channelID string, sequence uint64, sender sdk.AccAddress, amount sdk.Coin, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) InFlightPacket {
+	return InFlightPacket{
+		ChannelId:             channelID,
+		Sequence:              sequence,
+		Sender:                sender.String(),
+		Amount:                amount,
+		TimeoutRevisionNumber: timeoutHeight.RevisionNumber,
+		TimeoutRevisionHeight: timeoutHeight.RevisionHeight,
+		TimeoutTimestamp:      timeoutTimestamp,
+	}
+}
+
+// TimeoutHeight returns the timeout height of the packet.
+func (p InFlightPacket) TimeoutHeight() clienttypes.Height {
+	return clienttypes.NewHeight(p.TimeoutRevisionNumber, p.TimeoutRevisionHeight)
+}
+
+// TimedOut returns whether the packet can no longer be received by the
+// counterparty chain, given the latest height of the counterparty client and
+// the block time of the Hub. The clocks of the chains are assumed to be close
+// enough for the timeout timestamp to have passed on the counterparty chain
+// once it has on the Hub.
+func (p InFlightPacket) TimedOut(latestHeight exported.Height, blockTime time.Time) bool {
+	if p.TimeoutTimestamp != 0 && uint64(blockTime.UnixNano()) >= p.TimeoutTimestamp {
+		return true
+	}
+
+	timeoutHeight := p.TimeoutHeight()
+	return !timeoutHeight.IsZero() && latestHeight != nil && latestHeight.GTE(timeoutHeight)
+}
+
+// Validate performs a basic validation of the packet.
+func (p InFlightPacket) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Sender); err != nil {
+		return fmt.Errorf("invalid sender: %w", err)
+	}
+	if err := p.Amount.Validate(); err != nil {
+		return err
+	}
+	if !p.Amount.IsPositive() {
+		return fmt.Errorf("non positive amount %s", p.Amount)
+	}
+
+	return nil
+}
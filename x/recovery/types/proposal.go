@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const (
+	// ProposalTypeRecoverEscrow defines the type for a RecoverEscrowProposal.
+	ProposalTypeRecoverEscrow = "RecoverEscrow"
+)
+
+var _ govtypes.Content = &RecoverEscrowProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRecoverEscrow)
+}
+
+// NewRecoverEscrowProposal creates a new recover escrow proposal.
+func NewRecoverEscrowProposal(title, description, channelID string) govtypes.Content {
+	return &RecoverEscrowProposal{
+		Title:       title,
+		Description: description,
+		ChannelId:   channelID,
+	}
+}
+
+// GetTitle returns the title of a recover escrow proposal.
+func (p *RecoverEscrowProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a recover escrow proposal.
+func (p *RecoverEscrowProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a recover escrow proposal.
+func (p *RecoverEscrowProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a recover escrow proposal.
+func (p *RecoverEscrowProposal) ProposalType() string { return ProposalTypeRecoverEscrow }
+
+// ValidateBasic runs basic stateless validity checks.
+func (p *RecoverEscrowProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidProposal, err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p RecoverEscrowProposal) String() string {
+	return fmt.Sprintf(`Recover Escrow Proposal:
+  Title:       %s
+  Description: %s
+  Channel:     %s
+`, p.Title, p.Description, p.ChannelId)
+}
@@ -0,0 +1,893 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/recovery/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryRecoverableEscrowRequest is the request type for the
+// Query/RecoverableEscrow RPC method.
+type QueryRecoverableEscrowRequest struct {
+	// channel_id is the id of the transfer channel on the Hub.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryRecoverableEscrowRequest) Reset()         { *m = QueryRecoverableEscrowRequest{} }
+func (m *QueryRecoverableEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoverableEscrowRequest) ProtoMessage()    {}
+func (*QueryRecoverableEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e02ac5c61eb484d4, []int{0}
+}
+func (m *QueryRecoverableEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoverableEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoverableEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecoverableEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoverableEscrowRequest.Merge(m, src)
+}
+func (m *QueryRecoverableEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoverableEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoverableEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecoverableEscrowRequest proto.InternalMessageInfo
+
+func (m *QueryRecoverableEscrowRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryRecoverableEscrowResponse is the response type for the
+// Query/RecoverableEscrow RPC method.
+type QueryRecoverableEscrowResponse struct {
+	// channel_state is the state of the channel.
+	ChannelState string `protobuf:"bytes,1,opt,name=channel_state,json=channelState,proto3" json:"channel_state,omitempty"`
+	// client_status is the status of the counterparty client of the channel.
+	ClientStatus string `protobuf:"bytes,2,opt,name=client_status,json=clientStatus,proto3" json:"client_status,omitempty"`
+	// recoverable is whether a recover escrow proposal can be executed for the
+	// channel, that is whether the channel is closed or its client is not
+	// active.
+	Recoverable bool `protobuf:"varint,3,opt,name=recoverable,proto3" json:"recoverable,omitempty"`
+	// packets are the in-flight packets whose escrowed tokens would be
+	// returned to their senders.
+	Packets []InFlightPacket `protobuf:"bytes,4,rep,name=packets,proto3" json:"packets"`
+	// pending_packets are the in-flight packets of an open channel which have
+	// not timed out yet, and could still be received by the counterparty
+	// chain. Their escrowed tokens are not returned.
+	PendingPackets []InFlightPacket `protobuf:"bytes,5,rep,name=pending_packets,json=pendingPackets,proto3" json:"pending_packets"`
+	// total is the total amount returned to the senders.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+}
+
+func (m *QueryRecoverableEscrowResponse) Reset()         { *m = QueryRecoverableEscrowResponse{} }
+func (m *QueryRecoverableEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoverableEscrowResponse) ProtoMessage()    {}
+func (*QueryRecoverableEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e02ac5c61eb484d4, []int{1}
+}
+func (m *QueryRecoverableEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoverableEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoverableEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecoverableEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoverableEscrowResponse.Merge(m, src)
+}
+func (m *QueryRecoverableEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoverableEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoverableEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecoverableEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryRecoverableEscrowResponse) GetChannelState() string {
+	if m != nil {
+		return m.ChannelState
+	}
+	return ""
+}
+
+func (m *QueryRecoverableEscrowResponse) GetClientStatus() string {
+	if m != nil {
+		return m.ClientStatus
+	}
+	return ""
+}
+
+func (m *QueryRecoverableEscrowResponse) GetRecoverable() bool {
+	if m != nil {
+		return m.Recoverable
+	}
+	return false
+}
+
+func (m *QueryRecoverableEscrowResponse) GetPackets() []InFlightPacket {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *QueryRecoverableEscrowResponse) GetPendingPackets() []InFlightPacket {
+	if m != nil {
+		return m.PendingPackets
+	}
+	return nil
+}
+
+func (m *QueryRecoverableEscrowResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryRecoverableEscrowRequest)(nil), "gaia.recovery.v1beta1.QueryRecoverableEscrowRequest")
+	proto.RegisterType((*QueryRecoverableEscrowResponse)(nil), "gaia.recovery.v1beta1.QueryRecoverableEscrowResponse")
+}
+
+func init() { proto.RegisterFile("gaia/recovery/v1beta1/query.proto", fileDescriptor_e02ac5c61eb484d4) }
+
+var fileDescriptor_e02ac5c61eb484d4 = []byte{
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0x4d, 0x53, 0xed, 0xd4, 0x1f, 0x38, 0x28, 0xac, 0xc1, 0x6e, 0x63, 0x54, 0x08,
+	0x82, 0x3b, 0xb6, 0x2a, 0x14, 0x0f, 0x22, 0xd1, 0x0a, 0xbd, 0xd5, 0xad, 0x27, 0x2f, 0x65, 0x76,
+	0xf3, 0x98, 0x0c, 0xdd, 0xce, 0xdb, 0xee, 0x4c, 0xa2, 0x41, 0xbc, 0x78, 0xf6, 0x20, 0xf8, 0x5f,
+	0xf8, 0x67, 0x78, 0xea, 0xc1, 0x43, 0xc1, 0x8b, 0x27, 0x95, 0xc4, 0x3f, 0x44, 0x76, 0x76, 0x92,
+	0x14, 0x9a, 0x16, 0x72, 0xda, 0xe5, 0x3b, 0xdf, 0xf7, 0x79, 0x8f, 0xf7, 0x7d, 0xe4, 0xb6, 0xe0,
+	0x92, 0xb3, 0x1c, 0x12, 0xec, 0x43, 0x3e, 0x60, 0xfd, 0xf5, 0x18, 0x0c, 0x5f, 0x67, 0x87, 0x3d,
+	0xc8, 0x07, 0x61, 0x96, 0xa3, 0x41, 0x7a, 0xa3, 0xb0, 0x84, 0x63, 0x4b, 0xe8, 0x2c, 0xf5, 0xeb,
+	0x02, 0x05, 0x5a, 0x07, 0x2b, 0xfe, 0x4a, 0x73, 0xfd, 0x96, 0x40, 0x14, 0x29, 0x30, 0x9e, 0x49,
+	0xc6, 0x95, 0x42, 0xc3, 0x8d, 0x44, 0xa5, 0xdd, 0x6b, 0x90, 0xa0, 0x3e, 0x40, 0xcd, 0x62, 0xae,
+	0x61, 0xd2, 0x2b, 0x41, 0xa9, 0xdc, 0xfb, 0xdd, 0xd9, 0xd3, 0x4c, 0x7a, 0x5b, 0x57, 0xf3, 0x19,
+	0x59, 0x7d, 0x5d, 0xcc, 0x17, 0x95, 0x32, 0x8f, 0x53, 0xd8, 0xd2, 0x49, 0x8e, 0xef, 0x22, 0x38,
+	0xec, 0x81, 0x36, 0x74, 0x95, 0x90, 0xa4, 0xcb, 0x95, 0x82, 0x74, 0x4f, 0x76, 0x7c, 0xaf, 0xe1,
+	0xb5, 0x96, 0xa3, 0x65, 0xa7, 0x6c, 0x77, 0x9a, 0x9f, 0xab, 0x24, 0x38, 0x0b, 0xa0, 0x33, 0x54,
+	0x1a, 0xe8, 0x1d, 0x72, 0x79, 0x4c, 0xd0, 0x86, 0x1b, 0x70, 0x90, 0x4b, 0x4e, 0xdc, 0x2d, 0x34,
+	0x6b, 0x4a, 0x25, 0x28, 0x63, 0x3d, 0x3d, 0xed, 0x2f, 0x38, 0x93, 0x15, 0x77, 0xad, 0x46, 0x1b,
+	0x64, 0x25, 0x9f, 0xb6, 0xf1, 0xab, 0x0d, 0xaf, 0x75, 0x31, 0x3a, 0x29, 0xd1, 0x2d, 0x72, 0x21,
+	0xe3, 0xc9, 0x3e, 0x18, 0xed, 0x2f, 0x36, 0xaa, 0xad, 0x95, 0x8d, 0x7b, 0xe1, 0xcc, 0x8d, 0x87,
+	0xdb, 0xea, 0x55, 0x2a, 0x45, 0xd7, 0xec, 0x58, 0x77, 0x7b, 0xf1, 0xe8, 0xf7, 0x5a, 0x25, 0x1a,
+	0xd7, 0xd2, 0x37, 0xe4, 0x6a, 0x06, 0xaa, 0x23, 0x95, 0xd8, 0x1b, 0xe3, 0x6a, 0xf3, 0xe3, 0xae,
+	0x38, 0xc6, 0x8e, 0xa3, 0x72, 0x52, 0x33, 0x68, 0x78, 0xea, 0x2f, 0x59, 0xd6, 0xcd, 0xb0, 0x4c,
+	0x30, 0x2c, 0x12, 0x9c, 0x90, 0x5e, 0xa0, 0x54, 0xed, 0x87, 0x45, 0xfd, 0xb7, 0x3f, 0x6b, 0x2d,
+	0x21, 0x4d, 0xb7, 0x17, 0x87, 0x09, 0x1e, 0x30, 0x17, 0x77, 0xf9, 0x79, 0xa0, 0x3b, 0xfb, 0xcc,
+	0x0c, 0x32, 0xd0, 0xb6, 0x40, 0x47, 0x25, 0x79, 0xe3, 0x87, 0x47, 0x6a, 0x36, 0x0e, 0xfa, 0xdd,
+	0x23, 0xd7, 0x4e, 0x65, 0x42, 0x1f, 0x9f, 0x31, 0xff, 0xb9, 0x37, 0x50, 0x7f, 0x32, 0x67, 0x55,
+	0x19, 0x7c, 0xf3, 0xf9, 0xa7, 0x9f, 0xff, 0xbe, 0x2e, 0x3c, 0xa5, 0x9b, 0x6c, 0xf6, 0x29, 0xba,
+	0x03, 0xd0, 0xec, 0xc3, 0xf4, 0xc2, 0x3e, 0xb2, 0x13, 0x71, 0xb6, 0x5f, 0x1e, 0x0d, 0x03, 0xef,
+	0x78, 0x18, 0x78, 0x7f, 0x87, 0x81, 0xf7, 0x65, 0x14, 0x54, 0x8e, 0x47, 0x41, 0xe5, 0xd7, 0x28,
+	0xa8, 0xbc, 0xbd, 0x7f, 0x7a, 0x33, 0xb6, 0x49, 0x7f, 0x93, 0xbd, 0x9f, 0x76, 0xb2, 0x1b, 0x8a,
+	0x97, 0xec, 0xa9, 0x3f, 0xfa, 0x3f, 0x00, 0xa9, 0x8c, 0x60, 0xf6, 0xa0, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// RecoverableEscrow returns, as a dry run of a recover escrow proposal, the
+	// in-flight transfer packets of a channel whose escrowed tokens the
+	// proposal would return to their senders.
+	RecoverableEscrow(ctx context.Context, in *QueryRecoverableEscrowRequest, opts ...grpc.CallOption) (*QueryRecoverableEscrowResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) RecoverableEscrow(ctx context.Context, in *QueryRecoverableEscrowRequest, opts ...grpc.CallOption) (*QueryRecoverableEscrowResponse, error) {
+	out := new(QueryRecoverableEscrowResponse)
+	err := c.cc.Invoke(ctx, "/gaia.recovery.v1beta1.Query/RecoverableEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// RecoverableEscrow returns, as a dry run of a recover escrow proposal, the
+	// in-flight transfer packets of a channel whose escrowed tokens the
+	// proposal would return to their senders.
+	RecoverableEscrow(context.Context, *QueryRecoverableEscrowRequest) (*QueryRecoverableEscrowResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) RecoverableEscrow(ctx context.Context, req *QueryRecoverableEscrowRequest) (*QueryRecoverableEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverableEscrow not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_RecoverableEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoverableEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecoverableEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.recovery.v1beta1.Query/RecoverableEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecoverableEscrow(ctx, req.(*QueryRecoverableEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.recovery.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecoverableEscrow",
+			Handler:    _Query_RecoverableEscrow_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/recovery/v1beta1/query.proto",
+}
+
+func (m *QueryRecoverableEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoverableEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoverableEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoverableEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoverableEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoverableEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PendingPackets) > 0 {
+		for iNdEx := len(m.PendingPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Recoverable {
+		i--
+		if m.Recoverable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientStatus) > 0 {
+		i -= len(m.ClientStatus)
+		copy(dAtA[i:], m.ClientStatus)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientStatus)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelState) > 0 {
+		i -= len(m.ChannelState)
+		copy(dAtA[i:], m.ChannelState)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelState)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRecoverableEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecoverableEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelState)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientStatus)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Recoverable {
+		n += 2
+	}
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PendingPackets) > 0 {
+		for _, e := range m.PendingPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRecoverableEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecoverableEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecoverableEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecoverableEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecoverableEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecoverableEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recoverable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recoverable = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, InFlightPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPackets = append(m.PendingPackets, InFlightPacket{})
+			if err := m.PendingPackets[len(m.PendingPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
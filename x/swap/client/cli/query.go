@@ -0,0 +1,283 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/swap/types"
+)
+
+// Flags of the pool history query commands.
+const (
+	FlagInterval = "interval"
+	FlagStart    = "start"
+	FlagEnd      = "end"
+)
+
+// GetQueryCmd returns the cli query commands for the swap quoting service.
+func GetQueryCmd() *cobra.Command {
+	swapQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for quoting swaps against the liquidity pools",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	swapQueryCmd.AddCommand(
+		GetCmdQueryQuote(),
+		GetCmdQueryRoutes(),
+		GetCmdQueryCandles(),
+		GetCmdQueryTwap(),
+	)
+
+	return swapQueryCmd
+}
+
+// GetCmdQueryQuote implements the swap quote query command.
+func GetCmdQueryQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote [offer-coin] [demand-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the best route to swap a coin for another denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the best route, through a single pool or two pools sharing a common
+denom, to swap the offer coin for the demand denom, with the expected batch
+execution price, fees and price impact of each swap.
+
+Example:
+$ %s query %s quote 1000000uatom ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Quote(cmd.Context(), &types.QueryQuoteRequest{
+				OfferDenom:  offerCoin.Denom,
+				OfferAmount: offerCoin.Amount.String(),
+				DemandDenom: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRoutes implements the swap routes query command.
+func GetCmdQueryRoutes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "routes [offer-coin] [demand-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query every route to swap a coin for another denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query every route, through a single pool or two pools sharing a common
+denom, to swap the offer coin for the demand denom, from the best to the worst.
+
+Example:
+$ %s query %s routes 1000000uatom ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Routes(cmd.Context(), &types.QueryRoutesRequest{
+				OfferDenom:  offerCoin.Denom,
+				OfferAmount: offerCoin.Amount.String(),
+				DemandDenom: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCandles implements the pool candles query command.
+func GetCmdQueryCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the price and volume candles of a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the open, high, low and close prices and the volume of a pool over
+consecutive intervals, from the history recorded by the liquidity indexer of
+the node. Prices are expressed in the first reserve denom of the pool per the
+second one. The range defaults to the last 24 hours.
+
+Example:
+$ %s query %s candles 1 --interval 1h --start 2022-06-01T00:00:00Z --end 2022-06-02T00:00:00Z
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id %q: %w", args[0], err)
+			}
+			interval, err := cmd.Flags().GetDuration(FlagInterval)
+			if err != nil {
+				return err
+			}
+			if interval < time.Second {
+				return fmt.Errorf("interval must be at least one second")
+			}
+			start, end, err := timeRange(cmd, 24*time.Hour)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewHistoryClient(clientCtx)
+
+			res, err := queryClient.Candles(cmd.Context(), &types.QueryCandlesRequest{
+				PoolId:    poolID,
+				Interval:  uint64(interval / time.Second),
+				StartTime: start,
+				EndTime:   end,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagInterval, time.Hour, "Duration of each candle")
+	addTimeRangeFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTwap implements the pool TWAP query command.
+func GetCmdQueryTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the time-weighted average price of a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the time-weighted average price of a pool over a time range, from the
+history recorded by the liquidity indexer of the node. The price is expressed
+in the first reserve denom of the pool per the second one. The range defaults
+to the last hour.
+
+Example:
+$ %s query %s twap 1 --start 2022-06-01T00:00:00Z --end 2022-06-02T00:00:00Z
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id %q: %w", args[0], err)
+			}
+			start, end, err := timeRange(cmd, time.Hour)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewHistoryClient(clientCtx)
+
+			res, err := queryClient.Twap(cmd.Context(), &types.QueryTwapRequest{
+				PoolId:    poolID,
+				StartTime: start,
+				EndTime:   end,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addTimeRangeFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// addTimeRangeFlags adds the flags of the time range of a history query.
+func addTimeRangeFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagStart, "", "Start time of the range, in RFC 3339 format")
+	cmd.Flags().String(FlagEnd, "", "End time of the range, in RFC 3339 format (default now)")
+}
+
+// timeRange returns the unix times of the range of a history query. The end
+// time is zero when it defaults to the current time of the node, the start
+// time defaults to the given duration before the end time.
+func timeRange(cmd *cobra.Command, defaultDuration time.Duration) (start, end int64, err error) {
+	endTime := time.Now()
+	if s, _ := cmd.Flags().GetString(FlagEnd); s != "" {
+		if endTime, err = time.Parse(time.RFC3339, s); err != nil {
+			return 0, 0, fmt.Errorf("invalid end time: %w", err)
+		}
+		end = endTime.Unix()
+	}
+
+	startTime := endTime.Add(-defaultDuration)
+	if s, _ := cmd.Flags().GetString(FlagStart); s != "" {
+		if startTime, err = time.Parse(time.RFC3339, s); err != nil {
+			return 0, 0, fmt.Errorf("invalid start time: %w", err)
+		}
+	}
+
+	return startTime.Unix(), end, nil
+}
@@ -0,0 +1,130 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	liquiditytypes "github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/swap/types"
+)
+
+// maxCandles is the maximum number of candles returned by a query.
+const maxCandles = 1000
+
+var _ types.HistoryServer = Querier{}
+
+// Querier implements the History gRPC query service from the records of the
+// liquidity indexer. Queries fail with codes.Unavailable on nodes which do
+// not run the indexer.
+type Querier struct {
+	indexer *Indexer
+}
+
+// NewQuerier returns a new History Querier.
+func NewQuerier(indexer *Indexer) Querier {
+	return Querier{indexer: indexer}
+}
+
+// Candles implements the History/Candles gRPC method.
+func (q Querier) Candles(c context.Context, req *types.QueryCandlesRequest) (*types.QueryCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Interval == 0 {
+		return nil, status.Error(codes.InvalidArgument, "interval must be positive")
+	}
+
+	pool, start, end, err := q.query(sdk.UnwrapSDKContext(c), req.PoolId, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	interval := time.Duration(req.Interval) * time.Second
+	if count := (end.Sub(start) + interval - 1) / interval; count > maxCandles {
+		return nil, status.Errorf(codes.InvalidArgument, "range spans %d candles, more than the maximum of %d", count, maxCandles)
+	}
+
+	prior, records, err := q.records(pool, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCandlesResponse{
+		ReserveCoinDenoms: pool.ReserveCoinDenoms,
+		Candles:           candles(prior, records, start, end, interval),
+	}, nil
+}
+
+// Twap implements the History/Twap gRPC method.
+func (q Querier) Twap(c context.Context, req *types.QueryTwapRequest) (*types.QueryTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pool, start, end, err := q.query(sdk.UnwrapSDKContext(c), req.PoolId, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	prior, records, err := q.records(pool, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	price, from, ok := twap(prior, records, start, end)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no record of pool %d before %s", pool.Id, end.UTC().Format(time.RFC3339))
+	}
+
+	return &types.QueryTwapResponse{
+		ReserveCoinDenoms: pool.ReserveCoinDenoms,
+		Twap:              price,
+		StartTime:         from.Unix(),
+	}, nil
+}
+
+// query checks that the indexer is enabled and returns the queried pool and
+// time range, the end time defaulting to the current time.
+func (q Querier) query(ctx sdk.Context, poolID uint64, startTime, endTime int64) (pool liquiditytypes.Pool, start, end time.Time, err error) {
+	if !q.indexer.Enabled() {
+		return pool, start, end, status.Errorf(codes.Unavailable, "the liquidity indexer is not enabled on this node, set streaming.%s.enable to enable it", StreamerName)
+	}
+
+	pool, found := q.indexer.liquidityKeeper.GetPool(ctx, poolID)
+	if !found {
+		return pool, start, end, status.Errorf(codes.NotFound, "pool %d not found", poolID)
+	}
+
+	start, end = time.Unix(startTime, 0), time.Now()
+	if endTime != 0 {
+		end = time.Unix(endTime, 0)
+	}
+	if startTime <= 0 || !start.Before(end) {
+		return pool, start, end, status.Error(codes.InvalidArgument, "start time must be positive and before the end time")
+	}
+
+	return pool, start, end, nil
+}
+
+// records returns the last record of the pool before the start time, if any,
+// and its records of the range.
+func (q Querier) records(pool liquiditytypes.Pool, start, end time.Time) (*types.PoolRecord, []types.PoolRecord, error) {
+	var prior *types.PoolRecord
+	record, found, err := q.indexer.recordBefore(pool.Id, start.UnixNano())
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	if found {
+		prior = &record
+	}
+
+	records, err := q.indexer.records(pool.Id, start.UnixNano(), end.UnixNano())
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return prior, records, nil
+}
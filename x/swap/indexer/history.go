@@ -0,0 +1,98 @@
+package indexer
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v8/x/swap/types"
+)
+
+// candles returns the candles of the given interval from the start time to
+// the end time. prior is the last record before the start time, if any, and
+// records are the records of the range in chronological order. The price of
+// the pool is the price of its last record, so intervals without records
+// carry the previous close price, and intervals preceding the first record
+// are omitted.
+func candles(prior *types.PoolRecord, records []types.PoolRecord, start, end time.Time, interval time.Duration) []types.Candle {
+	var (
+		result []types.Candle
+		last   = prior
+		next   int
+	)
+
+	for t := start; t.Before(end); t = t.Add(interval) {
+		var candle *types.Candle
+		if last != nil {
+			candle = newCandle(t, last.Price)
+		}
+
+		for intervalEnd := t.Add(interval).UnixNano(); next < len(records) && records[next].Time < intervalEnd; next++ {
+			record := records[next]
+			if candle == nil {
+				candle = newCandle(t, record.Price)
+			}
+			if record.Price.GT(candle.High) {
+				candle.High = record.Price
+			}
+			if record.Price.LT(candle.Low) {
+				candle.Low = record.Price
+			}
+			candle.Close = record.Price
+			candle.Volume = candle.Volume.Add(record.Volume...)
+			last = &records[next]
+		}
+
+		if candle != nil {
+			result = append(result, *candle)
+		}
+	}
+
+	return result
+}
+
+// newCandle returns a candle opening at the given price.
+func newCandle(start time.Time, price sdk.Dec) *types.Candle {
+	return &types.Candle{
+		StartTime: start.Unix(),
+		Open:      price,
+		High:      price,
+		Low:       price,
+		Close:     price,
+	}
+}
+
+// twap returns the time-weighted average price from the start time to the
+// end time, and the time from which the price is known. prior is the last
+// record before the start time, if any, and records are the records of the
+// range in chronological order. It returns false if the price is unknown over
+// the whole range.
+func twap(prior *types.PoolRecord, records []types.PoolRecord, start, end time.Time) (sdk.Dec, time.Time, bool) {
+	var (
+		price sdk.Dec
+		known = prior != nil
+		from  = start.UnixNano()
+		t     = from
+		sum   = sdk.ZeroDec()
+	)
+	if known {
+		price = prior.Price
+	}
+
+	for _, record := range records {
+		if known {
+			sum = sum.Add(price.MulInt64(record.Time - t))
+		} else {
+			from, known = record.Time, true
+		}
+		t, price = record.Time, record.Price
+	}
+	if !known {
+		return sdk.Dec{}, time.Time{}, false
+	}
+
+	to := end.UnixNano()
+	sum = sum.Add(price.MulInt64(to - t))
+
+	return sum.QuoInt64(to - from), time.Unix(0, from), true
+}
@@ -0,0 +1,79 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v8/x/swap/types"
+)
+
+func newRecord(t time.Time, price string, volume ...sdk.Coin) types.PoolRecord {
+	return types.PoolRecord{
+		PoolId: 1,
+		Time:   t.UnixNano(),
+		Price:  sdk.MustNewDecFromStr(price),
+		Volume: sdk.NewCoins(volume...),
+	}
+}
+
+func TestCandles(t *testing.T) {
+	start := time.Unix(1_650_000_000, 0)
+	records := []types.PoolRecord{
+		newRecord(start.Add(10*time.Minute), "2", sdk.NewInt64Coin("uatom", 100)),
+		newRecord(start.Add(20*time.Minute), "3", sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("uosmo", 10)),
+		newRecord(start.Add(50*time.Minute), "1.5"),
+		newRecord(start.Add(130*time.Minute), "2.5", sdk.NewInt64Coin("uosmo", 20)),
+	}
+
+	// intervals preceding the first record are omitted, intervals without
+	// records carry the previous close
+	got := candles(nil, records, start.Add(-time.Hour), start.Add(3*time.Hour), time.Hour)
+	require.Len(t, got, 3)
+	require.Equal(t, types.Candle{
+		StartTime: start.Unix(),
+		Open:      sdk.NewDec(2),
+		High:      sdk.NewDec(3),
+		Low:       sdk.MustNewDecFromStr("1.5"),
+		Close:     sdk.MustNewDecFromStr("1.5"),
+		Volume:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 150), sdk.NewInt64Coin("uosmo", 10)),
+	}, got[0])
+	require.Equal(t, *newCandle(start.Add(time.Hour), sdk.MustNewDecFromStr("1.5")), got[1])
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), got[2].Open)
+	require.Equal(t, sdk.MustNewDecFromStr("2.5"), got[2].Close)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 20)), got[2].Volume)
+
+	// a record before the range opens the first candle
+	prior := newRecord(start.Add(-time.Minute), "4")
+	got = candles(&prior, records[:1], start, start.Add(time.Hour), time.Hour)
+	require.Len(t, got, 1)
+	require.Equal(t, sdk.NewDec(4), got[0].Open)
+	require.Equal(t, sdk.NewDec(4), got[0].High)
+	require.Equal(t, sdk.NewDec(2), got[0].Low)
+}
+
+func TestTwap(t *testing.T) {
+	start := time.Unix(1_650_000_000, 0)
+	records := []types.PoolRecord{
+		newRecord(start.Add(10*time.Minute), "2"),
+		newRecord(start.Add(40*time.Minute), "4"),
+	}
+
+	// the price is unknown before the first record
+	price, from, ok := twap(nil, records, start, start.Add(time.Hour))
+	require.True(t, ok)
+	require.Equal(t, start.Add(10*time.Minute), from)
+	require.Equal(t, sdk.MustNewDecFromStr("2.8"), price)
+
+	// a record before the range sets the price from the start
+	prior := newRecord(start.Add(-time.Minute), "1")
+	price, from, ok = twap(&prior, records, start, start.Add(time.Hour))
+	require.True(t, ok)
+	require.Equal(t, start, from)
+	require.Equal(t, sdk.MustNewDecFromStr("2.5"), price)
+
+	_, _, ok = twap(nil, nil, start, start.Add(time.Hour))
+	require.False(t, ok)
+}
@@ -0,0 +1,265 @@
+package indexer
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	liquiditytypes "github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+	"github.com/spf13/cast"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/gaia/v8/x/swap/types"
+)
+
+const (
+	// StreamerName is the name of the liquidity indexer streaming service,
+	// configured in the streaming.liquidity section of the application
+	// configuration.
+	StreamerName = "liquidity"
+
+	// dbName is the name of the indexer database.
+	dbName = "liquidity_index"
+
+	// dataDirKey is the configuration key of the directory holding the indexer
+	// database, which defaults to the data directory of the node.
+	dataDirKey = "streaming.liquidity.data-dir"
+)
+
+var _ baseapp.StreamingService = (*Indexer)(nil)
+
+// Indexer is a streaming service recording the reserves, price and volume of
+// every liquidity pool at the end of each executed batch into a node-local
+// database, from which the History gRPC service serves the price history of
+// the pools.
+//
+// A record is only written when the reserves of a pool changed or when the
+// pool had some volume, so the price of a pool at any time is the price of
+// its last record.
+type Indexer struct {
+	liquidityKeeper types.LiquidityKeeper
+
+	db dbm.DB
+	// last caches the last record of each pool.
+	last map[uint64]types.PoolRecord
+}
+
+// NewIndexer returns a new liquidity Indexer. The indexer is disabled until
+// it is opened by the streaming service loader.
+func NewIndexer(liquidityKeeper types.LiquidityKeeper) *Indexer {
+	return &Indexer{
+		liquidityKeeper: liquidityKeeper,
+		last:            make(map[uint64]types.PoolRecord),
+	}
+}
+
+// Open opens the indexer database and returns the indexer as a streaming
+// service. It implements the Gaia streaming.ServiceConstructor function, the
+// indexer does not listen to any store.
+func (i *Indexer) Open(opts servertypes.AppOptions, _ []storetypes.StoreKey, _ codec.Codec, _ log.Logger) (baseapp.StreamingService, error) {
+	dir := cast.ToString(opts.Get(dataDirKey))
+	if dir == "" {
+		dir = filepath.Join(cast.ToString(opts.Get(flags.FlagHome)), "data")
+	}
+
+	db, err := dbm.NewDB(dbName, server.GetAppDBBackend(opts), dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open liquidity index database: %w", err)
+	}
+	i.db = db
+
+	return i, nil
+}
+
+// Enabled returns true if the indexer has been opened.
+func (i *Indexer) Enabled() bool {
+	return i != nil && i.db != nil
+}
+
+// Stream implements baseapp.StreamingService. Records are written
+// synchronously by ListenEndBlock, so there is no streaming loop.
+func (i *Indexer) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService.
+func (i *Indexer) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (i *Indexer) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (i *Indexer) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener. The liquidity module
+// executes the pool batches in its EndBlocker every UnitBatchHeight blocks,
+// so the pools are recorded at the end of these blocks, reading their
+// reserves from the state of the block and their volume from the events of
+// the batch executions.
+func (i *Indexer) ListenEndBlock(ctx sdk.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	params := i.liquidityKeeper.GetParams(ctx)
+	if params.UnitBatchHeight == 0 || ctx.BlockHeight()%int64(params.UnitBatchHeight) != 0 {
+		return nil
+	}
+
+	volumes := batchVolumes(res.Events)
+
+	batch := i.db.NewBatch()
+	defer batch.Close()
+
+	var records []types.PoolRecord
+	for _, pool := range i.liquidityKeeper.GetAllPools(ctx) {
+		if len(pool.ReserveCoinDenoms) != 2 {
+			continue
+		}
+
+		reserves := i.liquidityKeeper.GetReserveCoins(ctx, pool)
+		reserveX, reserveY := reserves.AmountOf(pool.ReserveCoinDenoms[0]), reserves.AmountOf(pool.ReserveCoinDenoms[1])
+		if !reserveX.IsPositive() || !reserveY.IsPositive() {
+			// depleted pools have no price
+			continue
+		}
+
+		last, found, err := i.lastRecord(pool.Id)
+		if err != nil {
+			return err
+		}
+		volume := volumes[pool.Id]
+		if found && volume.Empty() &&
+			last.ReserveCoins.AmountOf(pool.ReserveCoinDenoms[0]).Equal(reserveX) &&
+			last.ReserveCoins.AmountOf(pool.ReserveCoinDenoms[1]).Equal(reserveY) {
+			continue
+		}
+
+		record := types.PoolRecord{
+			PoolId:       pool.Id,
+			Height:       ctx.BlockHeight(),
+			Time:         ctx.BlockTime().UnixNano(),
+			ReserveCoins: reserves,
+			Price:        reserveX.ToDec().QuoInt(reserveY),
+			Volume:       volume,
+		}
+		bz, err := record.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(recordKey(record.PoolId, record.Time), bz); err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("failed to write liquidity pool records: %w", err)
+	}
+	for _, record := range records {
+		i.last[record.PoolId] = record
+	}
+
+	return nil
+}
+
+// Close implements io.Closer.
+func (i *Indexer) Close() error {
+	if i.db == nil {
+		return nil
+	}
+
+	return i.db.Close()
+}
+
+// lastRecord returns the last record of the pool.
+func (i *Indexer) lastRecord(poolID uint64) (types.PoolRecord, bool, error) {
+	if record, ok := i.last[poolID]; ok {
+		return record, true, nil
+	}
+
+	record, found, err := i.recordBefore(poolID, maxTime)
+	if err != nil || !found {
+		return types.PoolRecord{}, false, err
+	}
+	i.last[poolID] = record
+
+	return record, true, nil
+}
+
+// batchVolumes returns the volume of each pool from the events of the
+// executed batches.
+func batchVolumes(events []abci.Event) map[uint64]sdk.Coins {
+	volumes := make(map[uint64]sdk.Coins)
+
+	for _, event := range events {
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		if attrs[liquiditytypes.AttributeValueSuccess] != liquiditytypes.Success {
+			continue
+		}
+		poolID, err := strconv.ParseUint(attrs[liquiditytypes.AttributeValuePoolId], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		var volume sdk.Coins
+		switch event.Type {
+		case liquiditytypes.EventTypeDepositToPool:
+			volume, err = sdk.ParseCoinsNormalized(attrs[liquiditytypes.AttributeValueAcceptedCoins])
+		case liquiditytypes.EventTypeWithdrawFromPool:
+			volume, err = sdk.ParseCoinsNormalized(attrs[liquiditytypes.AttributeValueWithdrawCoins])
+		case liquiditytypes.EventTypeSwapTransacted:
+			// swaps are no longer executed by liquidity v2, they are only
+			// accounted for if the module emits them again
+			volume, err = swapVolume(attrs)
+		default:
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		volumes[poolID] = volumes[poolID].Add(volume...)
+	}
+
+	return volumes
+}
+
+// swapVolume returns the offer and demand coins exchanged by a swap.
+func swapVolume(attrs map[string]string) (sdk.Coins, error) {
+	offer, ok := sdk.NewIntFromString(attrs[liquiditytypes.AttributeValueExchangedOfferCoinAmount])
+	if !ok {
+		return nil, fmt.Errorf("invalid exchanged offer coin amount")
+	}
+	demand, ok := sdk.NewIntFromString(attrs[liquiditytypes.AttributeValueExchangedDemandCoinAmount])
+	if !ok {
+		return nil, fmt.Errorf("invalid exchanged demand coin amount")
+	}
+
+	coins := []sdk.Coin{
+		{Denom: attrs[liquiditytypes.AttributeValueOfferCoinDenom], Amount: offer},
+		{Denom: attrs[liquiditytypes.AttributeValueDemandCoinDenom], Amount: demand},
+	}
+	for _, coin := range coins {
+		if err := coin.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return sdk.NewCoins(coins...), nil
+}
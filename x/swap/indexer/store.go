@@ -0,0 +1,76 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/cosmos/gaia/v8/x/swap/types"
+)
+
+// recordPrefix is the prefix of the keys of the pool records, which are
+// indexed by pool id and block time.
+var recordPrefix = []byte{0x01}
+
+// maxTime is the largest record time.
+const maxTime = math.MaxInt64
+
+// poolRecordsKey returns the key prefix of the records of a pool.
+func poolRecordsKey(poolID uint64) []byte {
+	key := make([]byte, len(recordPrefix)+8)
+	copy(key, recordPrefix)
+	binary.BigEndian.PutUint64(key[len(recordPrefix):], poolID)
+
+	return key
+}
+
+// recordKey returns the key of the record of a pool at the given unix time in
+// nanoseconds. Block times are positive, so their big endian encoding sorts
+// the records chronologically.
+func recordKey(poolID uint64, time int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(time))
+
+	return append(poolRecordsKey(poolID), key...)
+}
+
+// recordBefore returns the last record of the pool strictly before the given
+// time.
+func (i *Indexer) recordBefore(poolID uint64, before int64) (types.PoolRecord, bool, error) {
+	iter, err := i.db.ReverseIterator(poolRecordsKey(poolID), recordKey(poolID, before))
+	if err != nil {
+		return types.PoolRecord{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.PoolRecord{}, false, iter.Error()
+	}
+
+	var record types.PoolRecord
+	if err := record.Unmarshal(iter.Value()); err != nil {
+		return types.PoolRecord{}, false, err
+	}
+
+	return record, true, nil
+}
+
+// records returns the records of the pool from the start time, inclusive, to
+// the end time, exclusive, in chronological order.
+func (i *Indexer) records(poolID uint64, start, end int64) ([]types.PoolRecord, error) {
+	iter, err := i.db.Iterator(recordKey(poolID, start), recordKey(poolID, end))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var records []types.PoolRecord
+	for ; iter.Valid(); iter.Next() {
+		var record types.PoolRecord
+		if err := record.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, iter.Error()
+}
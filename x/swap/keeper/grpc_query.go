@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	liquiditytypes "github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/swap/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the swap gRPC query service, quoting swaps against the
+// current state of the liquidity pools and the pending orders of their
+// batches, following the batch execution model of the liquidity module.
+// Note that liquidity v2 accepts but no longer executes swap orders, so
+// quotes describe the pool pricing rather than a guaranteed outcome.
+type Querier struct {
+	liquidityKeeper types.LiquidityKeeper
+}
+
+// NewQuerier returns a new swap Querier.
+func NewQuerier(liquidityKeeper types.LiquidityKeeper) Querier {
+	return Querier{liquidityKeeper: liquidityKeeper}
+}
+
+// Quote implements the Query/Quote gRPC method.
+func (q Querier) Quote(c context.Context, req *types.QueryQuoteRequest) (*types.QueryQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	routes, err := q.routes(sdk.UnwrapSDKContext(c), req.OfferDenom, req.OfferAmount, req.DemandDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryQuoteResponse{Route: routes[0]}, nil
+}
+
+// Routes implements the Query/Routes gRPC method.
+func (q Querier) Routes(c context.Context, req *types.QueryRoutesRequest) (*types.QueryRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	routes, err := q.routes(sdk.UnwrapSDKContext(c), req.OfferDenom, req.OfferAmount, req.DemandDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRoutesResponse{Routes: routes}, nil
+}
+
+// routes returns the routes swapping the offer coin for the demand denom, from
+// the best to the worst. It returns an error if there is no route.
+func (q Querier) routes(ctx sdk.Context, offerDenom, offerAmount, demandDenom string) ([]types.SwapRoute, error) {
+	amount, ok := sdk.NewIntFromString(offerAmount)
+	if !ok || !amount.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid offer amount %q", offerAmount)
+	}
+	offer := sdk.Coin{Denom: offerDenom, Amount: amount}
+	if err := offer.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := sdk.ValidateDenom(demandDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if offerDenom == demandDenom {
+		return nil, status.Error(codes.InvalidArgument, "offer and demand denoms must be different")
+	}
+
+	params := q.liquidityKeeper.GetParams(ctx)
+	if params.CircuitBreakerEnabled {
+		return nil, types.ErrSwapsDisabled
+	}
+
+	var pools []poolState
+	for _, pool := range q.liquidityKeeper.GetAllPools(ctx) {
+		if len(pool.ReserveCoinDenoms) != 2 || q.liquidityKeeper.IsDepletedPool(ctx, pool) {
+			continue
+		}
+
+		var pending []*liquiditytypes.SwapMsgState
+		if batch, found := q.liquidityKeeper.GetPoolBatch(ctx, pool.Id); found {
+			pending = q.liquidityKeeper.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, batch)
+		}
+
+		pools = append(pools, newPoolState(pool, q.liquidityKeeper.GetReserveCoins(ctx, pool), pending))
+	}
+
+	routes := routes(pools, offer, demandDenom, params)
+	if len(routes) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrNoRoute, "from %s to %s", offer, demandDenom)
+	}
+
+	return routes, nil
+}
@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	liquiditytypes "github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+
+	"github.com/cosmos/gaia/v8/x/swap/types"
+)
+
+// swapOrder is a pending swap order of a pool batch.
+type swapOrder struct {
+	offerDenom  string
+	offerAmount sdk.Dec
+	// orderPrice is the limit price of the order, in X per Y.
+	orderPrice sdk.Dec
+}
+
+// poolState is the state of a liquidity pool used to quote swaps. Following
+// the liquidity module conventions, X is the alphabetically first reserve
+// denom and Y the second one, and prices are expressed in X per Y.
+type poolState struct {
+	id       uint64
+	denomX   string
+	denomY   string
+	reserveX sdk.Dec
+	reserveY sdk.Dec
+	orders   []swapOrder
+}
+
+func newPoolState(pool liquiditytypes.Pool, reserves sdk.Coins, pending []*liquiditytypes.SwapMsgState) poolState {
+	denomX, denomY := liquiditytypes.AlphabeticalDenomPair(pool.ReserveCoinDenoms[0], pool.ReserveCoinDenoms[1])
+
+	state := poolState{
+		id:       pool.Id,
+		denomX:   denomX,
+		denomY:   denomY,
+		reserveX: reserves.AmountOf(denomX).ToDec(),
+		reserveY: reserves.AmountOf(denomY).ToDec(),
+	}
+	for _, order := range pending {
+		if order.Msg == nil || !order.RemainingOfferCoin.IsPositive() {
+			continue
+		}
+
+		state.orders = append(state.orders, swapOrder{
+			offerDenom:  order.RemainingOfferCoin.Denom,
+			offerAmount: order.RemainingOfferCoin.Amount.ToDec(),
+			orderPrice:  order.Msg.OrderPrice,
+		})
+	}
+
+	return state
+}
+
+// hasDenoms returns true if the pool holds reserves of both denoms.
+func (p poolState) hasDenoms(denomA, denomB string) bool {
+	return (p.denomX == denomA && p.denomY == denomB) || (p.denomX == denomB && p.denomY == denomA)
+}
+
+// otherDenom returns the reserve denom of the pool which is not denom.
+func (p poolState) otherDenom(denom string) string {
+	if p.denomX == denom {
+		return p.denomY
+	}
+
+	return p.denomX
+}
+
+// reserve returns the reserve of the pool in the given denom.
+func (p poolState) reserve(denom string) sdk.Dec {
+	if p.denomX == denom {
+		return p.reserveX
+	}
+
+	return p.reserveY
+}
+
+// batchPrice returns the expected execution price of the pool batch, in X per
+// Y, once a market order offering offer is added to it.
+//
+// The liquidity module executes every order of a batch at a single price
+// following the equivalent swap price model: with the X and Y amounts
+// offered by the matched orders, the batch price is
+// (reserveX + 2 * offeredX) / (reserveY + 2 * offeredY). Pending orders whose
+// limit price is not satisfied by the batch price are not matched, so they
+// are excluded until the price settles.
+func (p poolState) batchPrice(offer sdk.Coin) sdk.Dec {
+	matched := append([]swapOrder{}, p.orders...)
+
+	for {
+		offeredX, offeredY := sdk.ZeroDec(), sdk.ZeroDec()
+		if offer.Denom == p.denomX {
+			offeredX = offer.Amount.ToDec()
+		} else {
+			offeredY = offer.Amount.ToDec()
+		}
+		for _, order := range matched {
+			if order.offerDenom == p.denomX {
+				offeredX = offeredX.Add(order.offerAmount)
+			} else {
+				offeredY = offeredY.Add(order.offerAmount)
+			}
+		}
+
+		price := p.reserveX.Add(offeredX.MulInt64(2)).Quo(p.reserveY.Add(offeredY.MulInt64(2)))
+
+		remaining := matched[:0]
+		for _, order := range matched {
+			// orders offering X buy Y up to their price, orders offering Y
+			// sell Y down to their price
+			if (order.offerDenom == p.denomX && order.orderPrice.GTE(price)) ||
+				(order.offerDenom == p.denomY && order.orderPrice.LTE(price)) {
+				remaining = append(remaining, order)
+			}
+		}
+		if len(remaining) == len(matched) {
+			return price
+		}
+		matched = remaining
+	}
+}
+
+// quote returns the quote of a swap of the offer coin in the pool. It returns
+// false if the liquidity module would reject the order.
+func (p poolState) quote(offer sdk.Coin, params liquiditytypes.Params) (types.SwapHop, bool) {
+	demandDenom := p.otherDenom(offer.Denom)
+	if offer.Amount.LT(liquiditytypes.MinOfferCoinAmount) ||
+		offer.Amount.ToDec().GT(p.reserve(offer.Denom).Mul(params.MaxOrderAmountRatio)) {
+		return types.SwapHop{}, false
+	}
+
+	// prices are expressed in offer denom per demand denom
+	poolPrice := p.reserve(offer.Denom).Quo(p.reserve(demandDenom))
+	swapPrice := p.batchPrice(offer)
+	if offer.Denom == p.denomY {
+		swapPrice = sdk.OneDec().Quo(swapPrice)
+	}
+
+	exchanged := offer.Amount.ToDec().Quo(swapPrice)
+	demandFee := exchanged.Mul(params.SwapFeeRate.QuoInt64(2)).Ceil().TruncateInt()
+	demand := exchanged.TruncateInt().Sub(demandFee)
+	if !demand.IsPositive() {
+		return types.SwapHop{}, false
+	}
+
+	return types.SwapHop{
+		PoolId:        p.id,
+		OfferCoin:     offer,
+		OfferCoinFee:  liquiditytypes.GetOfferCoinFee(offer, params.SwapFeeRate),
+		DemandCoin:    sdk.NewCoin(demandDenom, demand),
+		DemandCoinFee: sdk.NewCoin(demandDenom, demandFee),
+		PoolPrice:     poolPrice,
+		SwapPrice:     swapPrice,
+		PriceImpact:   swapPrice.Quo(poolPrice).Sub(sdk.OneDec()),
+	}, true
+}
+
+// maxOfferWithFee returns the largest offer coin which can be swapped, with
+// its offer coin fee, using the given coin.
+func maxOfferWithFee(coin sdk.Coin, swapFeeRate sdk.Dec) sdk.Coin {
+	offer := sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Quo(sdk.OneDec().Add(swapFeeRate.QuoInt64(2))).TruncateInt())
+	// the offer coin fee is rounded up, so the offer may need to be decreased
+	for offer.IsPositive() && offer.Add(liquiditytypes.GetOfferCoinFee(offer, swapFeeRate)).Amount.GT(coin.Amount) {
+		offer.Amount = offer.Amount.SubRaw(1)
+	}
+
+	return offer
+}
+
+// newRoute builds a swap route from the quotes of its hops.
+func newRoute(hops []types.SwapHop) types.SwapRoute {
+	first, last := hops[0], hops[len(hops)-1]
+
+	spotPrice, swapPrice := sdk.OneDec(), sdk.OneDec()
+	for _, hop := range hops {
+		spotPrice = spotPrice.Mul(hop.PoolPrice)
+		swapPrice = swapPrice.Mul(hop.SwapPrice)
+	}
+
+	return types.SwapRoute{
+		Hops:         hops,
+		OfferCoin:    first.OfferCoin,
+		OfferCoinFee: first.OfferCoinFee,
+		DemandCoin:   last.DemandCoin,
+		Price:        first.OfferCoin.Amount.Add(first.OfferCoinFee.Amount).ToDec().QuoInt(last.DemandCoin.Amount),
+		PriceImpact:  swapPrice.Quo(spotPrice).Sub(sdk.OneDec()),
+	}
+}
+
+// routes returns the quotes of every route, through a single pool or two
+// pools sharing a common denom, swapping the offer coin for the demand denom,
+// from the best to the worst.
+func routes(pools []poolState, offer sdk.Coin, demandDenom string, params liquiditytypes.Params) []types.SwapRoute {
+	var routes []types.SwapRoute
+
+	for _, first := range pools {
+		if first.denomX != offer.Denom && first.denomY != offer.Denom {
+			continue
+		}
+
+		firstHop, ok := first.quote(offer, params)
+		if !ok {
+			continue
+		}
+
+		intermediate := firstHop.DemandCoin.Denom
+		if intermediate == demandDenom {
+			routes = append(routes, newRoute([]types.SwapHop{firstHop}))
+			continue
+		}
+
+		for _, second := range pools {
+			if second.id == first.id || !second.hasDenoms(intermediate, demandDenom) {
+				continue
+			}
+
+			secondHop, ok := second.quote(maxOfferWithFee(firstHop.DemandCoin, params.SwapFeeRate), params)
+			if !ok {
+				continue
+			}
+
+			routes = append(routes, newRoute([]types.SwapHop{firstHop, secondHop}))
+		}
+	}
+
+	// the best route yields the largest demand coin, preferring fewer hops
+	sort.SliceStable(routes, func(i, j int) bool {
+		if !routes[i].DemandCoin.Amount.Equal(routes[j].DemandCoin.Amount) {
+			return routes[i].DemandCoin.Amount.GT(routes[j].DemandCoin.Amount)
+		}
+
+		return len(routes[i].Hops) < len(routes[j].Hops)
+	})
+
+	return routes
+}
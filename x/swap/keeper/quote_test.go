@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	liquiditytypes "github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolQuote(t *testing.T) {
+	params := liquiditytypes.DefaultParams()
+	pool := poolState{
+		id:       1,
+		denomX:   "uatom",
+		denomY:   "uosmo",
+		reserveX: sdk.NewDec(1_000_000),
+		reserveY: sdk.NewDec(2_000_000),
+	}
+
+	hop, ok := pool.quote(sdk.NewInt64Coin("uatom", 10_000), params)
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), hop.PoolPrice)
+	require.Equal(t, sdk.MustNewDecFromStr("0.51"), hop.SwapPrice)
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), hop.PriceImpact)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 15), hop.OfferCoinFee)
+	require.Equal(t, sdk.NewInt64Coin("uosmo", 30), hop.DemandCoinFee)
+	require.Equal(t, sdk.NewInt64Coin("uosmo", 19_577), hop.DemandCoin)
+
+	// a pending order in the opposite direction offsets the price impact
+	pool.orders = []swapOrder{{offerDenom: "uosmo", offerAmount: sdk.NewDec(20_000), orderPrice: sdk.MustNewDecFromStr("0.4")}}
+	hop, ok = pool.quote(sdk.NewInt64Coin("uatom", 10_000), params)
+	require.True(t, ok)
+	require.True(t, hop.PriceImpact.IsZero())
+
+	// unless its limit price is not satisfied by the batch price
+	pool.orders[0].orderPrice = sdk.MustNewDecFromStr("0.6")
+	hop, ok = pool.quote(sdk.NewInt64Coin("uatom", 10_000), params)
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), hop.PriceImpact)
+
+	// orders exceeding the max order amount ratio are rejected
+	_, ok = pool.quote(sdk.NewInt64Coin("uatom", 200_000), params)
+	require.False(t, ok)
+}
+
+func TestRoutes(t *testing.T) {
+	params := liquiditytypes.DefaultParams()
+	pools := []poolState{
+		{id: 1, denomX: "uatom", denomY: "uosmo", reserveX: sdk.NewDec(1_000_000), reserveY: sdk.NewDec(2_000_000)},
+		{id: 2, denomX: "uosmo", denomY: "ustake", reserveX: sdk.NewDec(2_000_000), reserveY: sdk.NewDec(2_000_000)},
+		{id: 3, denomX: "uatom", denomY: "ustake", reserveX: sdk.NewDec(100_000), reserveY: sdk.NewDec(200_000)},
+	}
+
+	routes := routes(pools, sdk.NewInt64Coin("uatom", 5_000), "ustake", params)
+	require.Len(t, routes, 2)
+
+	twoHops, direct := routes[0], routes[1]
+	require.Len(t, direct.Hops, 1)
+	require.Len(t, twoHops.Hops, 2)
+	require.Equal(t, uint64(3), direct.Hops[0].PoolId)
+	require.Equal(t, []uint64{1, 2}, []uint64{twoHops.Hops[0].PoolId, twoHops.Hops[1].PoolId})
+
+	// the second hop swaps what the first one yields, fee included
+	secondHop := twoHops.Hops[1]
+	require.True(t, secondHop.OfferCoin.Add(secondHop.OfferCoinFee).IsLTE(twoHops.Hops[0].DemandCoin))
+
+	// the deep two hops route beats the shallow direct pool
+	require.True(t, twoHops.DemandCoin.Amount.GT(direct.DemandCoin.Amount))
+	require.True(t, twoHops.PriceImpact.LT(direct.PriceImpact))
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/swap errors
+var (
+	ErrNoRoute       = sdkerrors.Register(ModuleName, 2, "no swap route")
+	ErrSwapsDisabled = sdkerrors.Register(ModuleName, 3, "swaps are disabled by the liquidity circuit breaker")
+)
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	liquiditytypes "github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+// LiquidityKeeper defines the expected liquidity keeper.
+type LiquidityKeeper interface {
+	GetParams(ctx sdk.Context) liquiditytypes.Params
+	GetAllPools(ctx sdk.Context) []liquiditytypes.Pool
+	GetPool(ctx sdk.Context, poolID uint64) (liquiditytypes.Pool, bool)
+	GetReserveCoins(ctx sdk.Context, pool liquiditytypes.Pool) sdk.Coins
+	IsDepletedPool(ctx sdk.Context, pool liquiditytypes.Pool) bool
+	GetPoolBatch(ctx sdk.Context, poolID uint64) (liquiditytypes.PoolBatch, bool)
+	GetAllNotProcessedPoolBatchSwapMsgStates(ctx sdk.Context, poolBatch liquiditytypes.PoolBatch) []*liquiditytypes.SwapMsgState
+}
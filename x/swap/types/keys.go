@@ -0,0 +1,10 @@
+package types
+
+const (
+	// ModuleName defines the name of the swap quoting service, used as
+	// codespace of its errors.
+	ModuleName = "swap"
+
+	// MaxRouteHops is the maximum number of pools a swap route goes through.
+	MaxRouteHops = 2
+)
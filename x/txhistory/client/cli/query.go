@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v8/x/txhistory/types"
+)
+
+// GetCmdQueryAccountTxs implements the account tx history query command.
+func GetCmdQueryAccountTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-txs [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the txs involving an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the txs involving an account, as sender, recipient, delegator, IBC
+receiver or interchain account, in the order they were executed, with the
+role of the account in each tx. The history is recorded by the tx history
+indexer, which must be enabled on the queried node.
+
+Example:
+$ %s query account-txs cosmos1qnk2n4nlkpw9xfqntladh74w6ujtulwn7j8za9 --reverse --limit 10
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountTxs(cmd.Context(), &types.QueryAccountTxsRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account txs")
+
+	return cmd
+}
@@ -0,0 +1,102 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v8/x/txhistory/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the tx history gRPC query service from the records of
+// the tx history indexer. Queries fail with codes.Unavailable on nodes which
+// do not run the indexer.
+type Querier struct {
+	indexer *Indexer
+}
+
+// NewQuerier returns a new tx history Querier.
+func NewQuerier(indexer *Indexer) Querier {
+	return Querier{indexer: indexer}
+}
+
+// AccountTxs implements the Query/AccountTxs gRPC method.
+func (q Querier) AccountTxs(_ context.Context, req *types.QueryAccountTxsRequest) (*types.QueryAccountTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !q.indexer.Enabled() {
+		return nil, status.Errorf(codes.Unavailable, "the tx history indexer is not enabled on this node, set streaming.%s.enable to enable it", StreamerName)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	var txs []types.AccountTx
+	store := prefix.NewStore(dbadapter.Store{DB: q.indexer.db}, accountTxsKey(addr))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		height, index := parseTxPosition(key)
+		record, found, err := q.indexer.txRecord(height, index)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return status.Errorf(codes.Internal, "missing record of tx %d of block %d", index, height)
+		}
+
+		tx, err := q.decodeTx(record.TxBytes)
+		if err != nil {
+			return err
+		}
+		record.TxBytes = nil
+
+		txs = append(txs, types.AccountTx{
+			Roles:  decodeRoles(value),
+			Result: record,
+			Tx:     tx,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountTxsResponse{
+		Txs:        txs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// decodeTx decodes the raw bytes of a tx.
+func (q Querier) decodeTx(txBytes []byte) (*txtypes.Tx, error) {
+	var raw txtypes.TxRaw
+	if err := q.indexer.cdc.Unmarshal(txBytes, &raw); err != nil {
+		return nil, err
+	}
+
+	var body txtypes.TxBody
+	if err := q.indexer.cdc.Unmarshal(raw.BodyBytes, &body); err != nil {
+		return nil, err
+	}
+	var authInfo txtypes.AuthInfo
+	if err := q.indexer.cdc.Unmarshal(raw.AuthInfoBytes, &authInfo); err != nil {
+		return nil, err
+	}
+
+	return &txtypes.Tx{
+		Body:       &body,
+		AuthInfo:   &authInfo,
+		Signatures: raw.Signatures,
+	}, nil
+}
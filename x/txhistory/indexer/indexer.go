@@ -0,0 +1,193 @@
+package indexer
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/gaia/v8/x/txhistory/types"
+)
+
+const (
+	// StreamerName is the name of the tx history indexer streaming service,
+	// configured in the streaming.txhistory section of the application
+	// configuration.
+	StreamerName = "txhistory"
+
+	// dbName is the name of the indexer database.
+	dbName = "tx_history"
+
+	// dataDirKey is the configuration key of the directory holding the indexer
+	// database, which defaults to the data directory of the node.
+	dataDirKey = "streaming.txhistory.data-dir"
+)
+
+var _ baseapp.StreamingService = (*Indexer)(nil)
+
+// Indexer is a streaming service recording the txs involving each account
+// into a node-local database, from which the Query gRPC service serves the tx
+// history of the accounts.
+//
+// The txs of a block are written at the end of the block, so a block
+// re-executed after a crash overwrites its own entries.
+type Indexer struct {
+	txDecoder sdk.TxDecoder
+
+	cdc codec.Codec
+	db  dbm.DB
+
+	// pending holds the txs of the current block.
+	pending []indexedTx
+}
+
+// indexedTx is a tx of the current block along with the roles of the accounts
+// it involves.
+type indexedTx struct {
+	record   types.TxRecord
+	accounts accountRoles
+}
+
+// NewIndexer returns a new tx history Indexer. The indexer is disabled until
+// it is opened by the streaming service loader.
+func NewIndexer(txDecoder sdk.TxDecoder) *Indexer {
+	return &Indexer{txDecoder: txDecoder}
+}
+
+// Open opens the indexer database and returns the indexer as a streaming
+// service. It implements the Gaia streaming.ServiceConstructor function, the
+// indexer does not listen to any store.
+func (i *Indexer) Open(opts servertypes.AppOptions, _ []storetypes.StoreKey, cdc codec.Codec, _ log.Logger) (baseapp.StreamingService, error) {
+	dir := cast.ToString(opts.Get(dataDirKey))
+	if dir == "" {
+		dir = filepath.Join(cast.ToString(opts.Get(flags.FlagHome)), "data")
+	}
+
+	db, err := dbm.NewDB(dbName, server.GetAppDBBackend(opts), dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open tx history database: %w", err)
+	}
+	i.cdc, i.db = cdc, db
+
+	return i, nil
+}
+
+// Enabled returns true if the indexer has been opened.
+func (i *Indexer) Enabled() bool {
+	return i != nil && i.db != nil
+}
+
+// Stream implements baseapp.StreamingService. Txs are written synchronously
+// by ListenEndBlock, so there is no streaming loop.
+func (i *Indexer) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService.
+func (i *Indexer) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (i *Indexer) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	i.pending = nil
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener, recording the accounts
+// involved in the tx. Txs which cannot be decoded involve no account.
+func (i *Indexer) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	record := types.TxRecord{
+		Height:    ctx.BlockHeight(),
+		Index:     uint32(len(i.pending)),
+		Hash:      fmt.Sprintf("%X", tmtypes.Tx(req.Tx).Hash()),
+		Time:      ctx.BlockTime().Unix(),
+		Code:      res.Code,
+		Codespace: res.Codespace,
+		GasWanted: res.GasWanted,
+		GasUsed:   res.GasUsed,
+		TxBytes:   req.Tx,
+	}
+
+	accounts := make(accountRoles)
+	if tx, err := i.txDecoder(req.Tx); err == nil {
+		accounts.addMsgs(i.cdc, tx.GetMsgs(), txSucceeded(res))
+	}
+	i.pending = append(i.pending, indexedTx{record: record, accounts: accounts})
+
+	return nil
+}
+
+// txSucceeded returns true if the tx succeeded. The SDK reports the failed txs
+// to the listeners with an empty response, while executed txs always emit the
+// events of the ante handler, so a successful tx has events.
+func txSucceeded(res abci.ResponseDeliverTx) bool {
+	return res.IsOK() && len(res.Events) > 0
+}
+
+// ListenEndBlock implements baseapp.ABCIListener, writing the txs of the
+// block.
+func (i *Indexer) ListenEndBlock(ctx sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	if len(i.pending) == 0 {
+		return nil
+	}
+	txs := i.pending
+	i.pending = nil
+
+	batch := i.db.NewBatch()
+	defer batch.Close()
+
+	for _, tx := range txs {
+		if len(tx.accounts) == 0 {
+			continue
+		}
+
+		bz, err := tx.record.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(txRecordKey(tx.record.Height, tx.record.Index), bz); err != nil {
+			return err
+		}
+
+		addrs := make([]string, 0, len(tx.accounts))
+		for addr := range tx.accounts {
+			addrs = append(addrs, addr)
+		}
+		sort.Strings(addrs)
+		for _, addr := range addrs {
+			key := accountTxKey([]byte(addr), tx.record.Height, tx.record.Index)
+			if err := batch.Set(key, encodeRoles(tx.accounts.roles(addr))); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("failed to write the txs of block %d: %w", ctx.BlockHeight(), err)
+	}
+
+	return nil
+}
+
+// Close implements io.Closer.
+func (i *Indexer) Close() error {
+	if i.db == nil {
+		return nil
+	}
+
+	return i.db.Close()
+}
@@ -0,0 +1,127 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/gaia/v8/x/txhistory/types"
+)
+
+func TestIndexer(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	authz.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+	channeltypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	i := NewIndexer(txConfig.TxDecoder())
+	i.cdc, i.db = cdc, dbm.NewMemDB()
+
+	alice, bob, carol := sdk.AccAddress("alice_______________"), sdk.AccAddress("bob_________________"), sdk.AccAddress("carol_______________")
+	relayer, ica := sdk.AccAddress("relayer_____________"), sdk.AccAddress("ica_________________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))
+
+	icaData, err := icatypes.SerializeCosmosTx(cdc, []sdk.Msg{banktypes.NewMsgSend(ica, carol, coins)})
+	require.NoError(t, err)
+	packets := []channeltypes.Packet{
+		channeltypes.NewPacket(
+			transfertypes.NewFungibleTokenPacketData("uatom", "1", "osmo1sender", bob.String()).GetBytes(),
+			1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0,
+		),
+		channeltypes.NewPacket(
+			icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: icaData}.GetBytes(),
+			1, icatypes.PortPrefix+"owner", "channel-2", icatypes.PortID, "channel-3", clienttypes.NewHeight(0, 100), 0,
+		),
+	}
+	exec := authz.NewMsgExec(bob, []sdk.Msg{stakingtypes.NewMsgDelegate(alice, sdk.ValAddress("validator"), coins[0])})
+
+	events := []abci.Event{{Type: sdk.EventTypeMessage}}
+	txs := []struct {
+		msgs []sdk.Msg
+		res  abci.ResponseDeliverTx
+	}{
+		{[]sdk.Msg{banktypes.NewMsgSend(alice, bob, coins)}, abci.ResponseDeliverTx{Events: events}},
+		// the recipients of failed txs are not involved
+		{[]sdk.Msg{banktypes.NewMsgSend(alice, carol, coins)}, abci.ResponseDeliverTx{Code: 5, Events: events}},
+		// nor those of the failed txs reported with an empty response
+		{[]sdk.Msg{banktypes.NewMsgSend(alice, carol, coins)}, abci.ResponseDeliverTx{}},
+		{[]sdk.Msg{&exec}, abci.ResponseDeliverTx{Events: events}},
+		{[]sdk.Msg{
+			channeltypes.NewMsgRecvPacket(packets[0], nil, clienttypes.NewHeight(0, 1), relayer.String()),
+			channeltypes.NewMsgRecvPacket(packets[1], nil, clienttypes.NewHeight(0, 1), relayer.String()),
+		}, abci.ResponseDeliverTx{Events: events}},
+	}
+
+	ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Height: 10, Time: time.Unix(1_650_000_000, 0)})
+	require.NoError(t, i.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+	for _, tx := range txs {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(tx.msgs...))
+		bz, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		require.NoError(t, i.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: bz}, tx.res))
+	}
+	// undecodable txs involve no account
+	require.NoError(t, i.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("invalid")}, abci.ResponseDeliverTx{Code: 2}))
+	require.NoError(t, i.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+
+	querier := NewQuerier(i)
+	accountTxs := func(addr sdk.AccAddress, pageReq *query.PageRequest) *types.QueryAccountTxsResponse {
+		res, err := querier.AccountTxs(context.Background(), &types.QueryAccountTxsRequest{Address: addr.String(), Pagination: pageReq})
+		require.NoError(t, err)
+		return res
+	}
+	expectTxs := func(addr sdk.AccAddress, indexes []uint32, roles [][]types.Role) {
+		res := accountTxs(addr, nil)
+		require.Len(t, res.Txs, len(indexes))
+		for j, tx := range res.Txs {
+			require.Equal(t, int64(10), tx.Result.Height)
+			require.Equal(t, int64(1_650_000_000), tx.Result.Time)
+			require.Equal(t, indexes[j], tx.Result.Index)
+			require.Equal(t, roles[j], tx.Roles)
+			require.Nil(t, tx.Result.TxBytes)
+			require.NotNil(t, tx.Tx.Body)
+		}
+	}
+
+	sender := []types.Role{types.RoleSender}
+	expectTxs(alice, []uint32{0, 1, 2, 3}, [][]types.Role{sender, sender, sender, {types.RoleSender, types.RoleDelegator}})
+	expectTxs(bob, []uint32{0, 3, 4}, [][]types.Role{{types.RoleRecipient}, sender, {types.RoleIBCReceiver}})
+	expectTxs(carol, []uint32{4}, [][]types.Role{{types.RoleRecipient}})
+	expectTxs(relayer, []uint32{4}, [][]types.Role{sender})
+	expectTxs(ica, []uint32{4}, [][]types.Role{{types.RoleSender, types.RoleInterchainAccount}})
+
+	// the history is paginated
+	res := accountTxs(alice, &query.PageRequest{Limit: 3, Reverse: true, CountTotal: true})
+	require.Len(t, res.Txs, 3)
+	require.Equal(t, uint32(3), res.Txs[0].Result.Index)
+	require.Equal(t, uint64(4), res.Pagination.Total)
+	res = accountTxs(alice, &query.PageRequest{Key: res.Pagination.NextKey, Reverse: true})
+	require.Len(t, res.Txs, 1)
+	require.Equal(t, uint32(0), res.Txs[0].Result.Index)
+
+	_, err = NewQuerier(NewIndexer(txConfig.TxDecoder())).AccountTxs(context.Background(), &types.QueryAccountTxsRequest{Address: alice.String()})
+	require.Error(t, err)
+}
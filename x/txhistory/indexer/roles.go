@@ -0,0 +1,129 @@
+package indexer
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	"github.com/cosmos/gaia/v8/x/txhistory/types"
+)
+
+// accountRoles are the roles of the accounts involved in a tx, keyed by
+// account address bytes.
+type accountRoles map[string]map[types.Role]bool
+
+// add adds a role of an account.
+func (a accountRoles) add(addr sdk.AccAddress, role types.Role) {
+	if len(addr) == 0 {
+		return
+	}
+
+	roles, ok := a[string(addr)]
+	if !ok {
+		roles = make(map[types.Role]bool)
+		a[string(addr)] = roles
+	}
+	roles[role] = true
+}
+
+// addBech32 adds a role of an account from its bech32 address, ignoring
+// invalid addresses.
+func (a accountRoles) addBech32(address string, role types.Role) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return
+	}
+
+	a.add(addr, role)
+}
+
+// roles returns the roles of an account, sorted.
+func (a accountRoles) roles(addr string) []types.Role {
+	roles := make([]types.Role, 0, len(a[addr]))
+	for role := range a[addr] {
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i] < roles[j] })
+
+	return roles
+}
+
+// addMsgs adds the accounts involved in the messages of a tx. The signers of
+// the messages are senders, the other accounts are only involved if the tx
+// succeeded.
+func (a accountRoles) addMsgs(cdc codec.Codec, msgs []sdk.Msg, success bool) {
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			a.add(signer, types.RoleSender)
+		}
+		if !success {
+			continue
+		}
+
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			a.addBech32(msg.ToAddress, types.RoleRecipient)
+		case *banktypes.MsgMultiSend:
+			for _, output := range msg.Outputs {
+				a.addBech32(output.Address, types.RoleRecipient)
+			}
+		case *stakingtypes.MsgDelegate:
+			a.addBech32(msg.DelegatorAddress, types.RoleDelegator)
+		case *stakingtypes.MsgUndelegate:
+			a.addBech32(msg.DelegatorAddress, types.RoleDelegator)
+		case *stakingtypes.MsgBeginRedelegate:
+			a.addBech32(msg.DelegatorAddress, types.RoleDelegator)
+		case *stakingtypes.MsgCancelUnbondingDelegation:
+			a.addBech32(msg.DelegatorAddress, types.RoleDelegator)
+		case *distrtypes.MsgWithdrawDelegatorReward:
+			a.addBech32(msg.DelegatorAddress, types.RoleDelegator)
+		case *distrtypes.MsgSetWithdrawAddress:
+			a.addBech32(msg.DelegatorAddress, types.RoleDelegator)
+		case *authz.MsgExec:
+			if inner, err := msg.GetMessages(); err == nil {
+				a.addMsgs(cdc, inner, success)
+			}
+		case *channeltypes.MsgRecvPacket:
+			a.addPacket(cdc, msg.Packet)
+		}
+	}
+}
+
+// addPacket adds the accounts involved in a received packet: the receiver of
+// an ICS-20 transfer, or the interchain account executing the messages of an
+// interchain accounts packet, along with the accounts involved in these
+// messages.
+func (a accountRoles) addPacket(cdc codec.Codec, packet channeltypes.Packet) {
+	switch packet.DestinationPort {
+	case transfertypes.PortID:
+		var data transfertypes.FungibleTokenPacketData
+		if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+			return
+		}
+		a.addBech32(data.Receiver, types.RoleIBCReceiver)
+
+	case icatypes.PortID:
+		var data icatypes.InterchainAccountPacketData
+		if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil || data.Type != icatypes.EXECUTE_TX {
+			return
+		}
+		msgs, err := icatypes.DeserializeCosmosTx(cdc, data.Data)
+		if err != nil {
+			return
+		}
+		for _, msg := range msgs {
+			for _, signer := range msg.GetSigners() {
+				a.add(signer, types.RoleInterchainAccount)
+			}
+		}
+		a.addMsgs(cdc, msgs, true)
+	}
+}
@@ -0,0 +1,87 @@
+package indexer
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/gaia/v8/x/txhistory/types"
+)
+
+var (
+	// accountTxPrefix is the prefix of the keys of the account txs, which are
+	// indexed by account address, block height and tx index, and hold the
+	// roles of the account in the tx.
+	accountTxPrefix = []byte{0x01}
+
+	// txRecordPrefix is the prefix of the keys of the tx records, which are
+	// indexed by block height and tx index.
+	txRecordPrefix = []byte{0x02}
+)
+
+// accountTxsKey returns the key prefix of the txs of an account.
+func accountTxsKey(addr []byte) []byte {
+	key := make([]byte, 0, len(accountTxPrefix)+1+len(addr))
+	key = append(key, accountTxPrefix...)
+	key = append(key, byte(len(addr)))
+
+	return append(key, addr...)
+}
+
+// accountTxKey returns the key of a tx of an account.
+func accountTxKey(addr []byte, height int64, index uint32) []byte {
+	return append(accountTxsKey(addr), txPosition(height, index)...)
+}
+
+// txRecordKey returns the key of the record of a tx.
+func txRecordKey(height int64, index uint32) []byte {
+	return append(append([]byte{}, txRecordPrefix...), txPosition(height, index)...)
+}
+
+// txPosition returns the block height and tx index encoded in big endian, so
+// that txs are sorted in execution order.
+func txPosition(height int64, index uint32) []byte {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	binary.BigEndian.PutUint32(bz[8:], index)
+
+	return bz
+}
+
+// parseTxPosition returns the block height and tx index of a tx position.
+func parseTxPosition(bz []byte) (int64, uint32) {
+	return int64(binary.BigEndian.Uint64(bz)), binary.BigEndian.Uint32(bz[8:])
+}
+
+// encodeRoles encodes the roles of an account in a tx, one byte per role.
+func encodeRoles(roles []types.Role) []byte {
+	bz := make([]byte, len(roles))
+	for i, role := range roles {
+		bz[i] = byte(role)
+	}
+
+	return bz
+}
+
+// decodeRoles decodes the roles of an account in a tx.
+func decodeRoles(bz []byte) []types.Role {
+	roles := make([]types.Role, len(bz))
+	for i, b := range bz {
+		roles[i] = types.Role(b)
+	}
+
+	return roles
+}
+
+// txRecord returns the record of a tx.
+func (i *Indexer) txRecord(height int64, index uint32) (types.TxRecord, bool, error) {
+	bz, err := i.db.Get(txRecordKey(height, index))
+	if err != nil || bz == nil {
+		return types.TxRecord{}, false, err
+	}
+
+	var record types.TxRecord
+	if err := record.Unmarshal(bz); err != nil {
+		return types.TxRecord{}, false, err
+	}
+
+	return record, true, nil
+}
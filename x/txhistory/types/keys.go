@@ -0,0 +1,4 @@
+package types
+
+// ModuleName defines the name of the tx history service.
+const ModuleName = "txhistory"
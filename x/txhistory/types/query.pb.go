@@ -0,0 +1,1636 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/txhistory/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	tx "github.com/cosmos/cosmos-sdk/types/tx"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Role is the role of an account in a tx.
+type Role int32
+
+const (
+	// ROLE_UNSPECIFIED defines a no-op role.
+	RoleUnspecified Role = 0
+	// ROLE_SENDER defines a signer of a message of the tx.
+	RoleSender Role = 1
+	// ROLE_RECIPIENT defines a recipient of a bank transfer.
+	RoleRecipient Role = 2
+	// ROLE_DELEGATOR defines the delegator of a staking or distribution message.
+	RoleDelegator Role = 3
+	// ROLE_IBC_RECEIVER defines the receiver of an ICS-20 transfer packet.
+	RoleIBCReceiver Role = 4
+	// ROLE_INTERCHAIN_ACCOUNT defines an interchain account hosted on this
+	// chain, executing the messages of a packet sent by its owner on the
+	// controller chain.
+	RoleInterchainAccount Role = 5
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "ROLE_SENDER",
+	2: "ROLE_RECIPIENT",
+	3: "ROLE_DELEGATOR",
+	4: "ROLE_IBC_RECEIVER",
+	5: "ROLE_INTERCHAIN_ACCOUNT",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED":        0,
+	"ROLE_SENDER":             1,
+	"ROLE_RECIPIENT":          2,
+	"ROLE_DELEGATOR":          3,
+	"ROLE_IBC_RECEIVER":       4,
+	"ROLE_INTERCHAIN_ACCOUNT": 5,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_26418d4e12bac5ba, []int{0}
+}
+
+// QueryAccountTxsRequest is the request type for the Query/AccountTxs RPC
+// method.
+type QueryAccountTxsRequest struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountTxsRequest) Reset()         { *m = QueryAccountTxsRequest{} }
+func (m *QueryAccountTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountTxsRequest) ProtoMessage()    {}
+func (*QueryAccountTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26418d4e12bac5ba, []int{0}
+}
+func (m *QueryAccountTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountTxsRequest.Merge(m, src)
+}
+func (m *QueryAccountTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountTxsRequest proto.InternalMessageInfo
+
+func (m *QueryAccountTxsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAccountTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountTxsResponse is the response type for the Query/AccountTxs RPC
+// method.
+type QueryAccountTxsResponse struct {
+	// txs are the txs involving the account.
+	Txs []AccountTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountTxsResponse) Reset()         { *m = QueryAccountTxsResponse{} }
+func (m *QueryAccountTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountTxsResponse) ProtoMessage()    {}
+func (*QueryAccountTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26418d4e12bac5ba, []int{1}
+}
+func (m *QueryAccountTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountTxsResponse.Merge(m, src)
+}
+func (m *QueryAccountTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountTxsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountTxsResponse) GetTxs() []AccountTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *QueryAccountTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// AccountTx is a tx involving an account.
+type AccountTx struct {
+	// roles are the roles of the account in the tx.
+	Roles []Role `protobuf:"varint,1,rep,packed,name=roles,proto3,enum=gaia.txhistory.v1beta1.Role" json:"roles,omitempty"`
+	// result is the result of the tx.
+	Result TxRecord `protobuf:"bytes,2,opt,name=result,proto3" json:"result"`
+	// tx is the decoded tx.
+	Tx *tx.Tx `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *AccountTx) Reset()         { *m = AccountTx{} }
+func (m *AccountTx) String() string { return proto.CompactTextString(m) }
+func (*AccountTx) ProtoMessage()    {}
+func (*AccountTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26418d4e12bac5ba, []int{2}
+}
+func (m *AccountTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTx.Merge(m, src)
+}
+func (m *AccountTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTx proto.InternalMessageInfo
+
+func (m *AccountTx) GetRoles() []Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *AccountTx) GetResult() TxRecord {
+	if m != nil {
+		return m.Result
+	}
+	return TxRecord{}
+}
+
+func (m *AccountTx) GetTx() *tx.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// TxRecord is the result of a tx recorded by the tx history indexer.
+type TxRecord struct {
+	// height is the height of the block of the tx.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// index is the index of the tx in the block.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// hash is the hex encoded hash of the tx.
+	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// time is the unix time, in seconds, of the block of the tx.
+	Time int64 `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	// code is the response code of the tx, 0 on success.
+	Code uint32 `protobuf:"varint,5,opt,name=code,proto3" json:"code,omitempty"`
+	// codespace is the namespace of the response code.
+	Codespace string `protobuf:"bytes,6,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// gas_wanted is the amount of gas requested by the tx.
+	GasWanted int64 `protobuf:"varint,7,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_used is the amount of gas consumed by the tx.
+	GasUsed int64 `protobuf:"varint,8,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// tx_bytes are the raw bytes of the tx, only set in the indexer database.
+	TxBytes []byte `protobuf:"bytes,9,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *TxRecord) Reset()         { *m = TxRecord{} }
+func (m *TxRecord) String() string { return proto.CompactTextString(m) }
+func (*TxRecord) ProtoMessage()    {}
+func (*TxRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26418d4e12bac5ba, []int{3}
+}
+func (m *TxRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxRecord.Merge(m, src)
+}
+func (m *TxRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxRecord proto.InternalMessageInfo
+
+func (m *TxRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TxRecord) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *TxRecord) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TxRecord) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *TxRecord) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *TxRecord) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *TxRecord) GetGasWanted() int64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *TxRecord) GetGasUsed() int64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *TxRecord) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("gaia.txhistory.v1beta1.Role", Role_name, Role_value)
+	proto.RegisterType((*QueryAccountTxsRequest)(nil), "gaia.txhistory.v1beta1.QueryAccountTxsRequest")
+	proto.RegisterType((*QueryAccountTxsResponse)(nil), "gaia.txhistory.v1beta1.QueryAccountTxsResponse")
+	proto.RegisterType((*AccountTx)(nil), "gaia.txhistory.v1beta1.AccountTx")
+	proto.RegisterType((*TxRecord)(nil), "gaia.txhistory.v1beta1.TxRecord")
+}
+
+func init() {
+	proto.RegisterFile("gaia/txhistory/v1beta1/query.proto", fileDescriptor_26418d4e12bac5ba)
+}
+
+var fileDescriptor_26418d4e12bac5ba = []byte{
+	// 784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6f, 0xe3, 0x44,
+	0x18, 0xc6, 0xe3, 0xfc, 0x6d, 0xa6, 0x6c, 0xc9, 0x0e, 0xbb, 0x5d, 0xaf, 0x55, 0xb2, 0x26, 0xd2,
+	0x42, 0x28, 0x92, 0xcd, 0x06, 0x69, 0x05, 0x17, 0xa4, 0xfc, 0x71, 0x8b, 0xa5, 0x2a, 0x2d, 0xd3,
+	0x04, 0x24, 0x2e, 0xd1, 0xc4, 0x7e, 0x71, 0x2c, 0xa5, 0x1e, 0xd7, 0x33, 0x29, 0x0e, 0x88, 0x0b,
+	0x27, 0x94, 0x13, 0x12, 0xe7, 0x9c, 0x10, 0x5c, 0xf9, 0x1a, 0x3d, 0x56, 0xe2, 0xc2, 0x09, 0xa1,
+	0x16, 0x89, 0xaf, 0x81, 0x3c, 0x76, 0x93, 0x22, 0x1a, 0xb1, 0x27, 0xcf, 0xcc, 0xfb, 0x7b, 0xde,
+	0xe7, 0x19, 0xdb, 0x33, 0xa8, 0xe1, 0x51, 0x9f, 0x9a, 0x22, 0x9e, 0xf8, 0x5c, 0xb0, 0x68, 0x6e,
+	0x5e, 0xbc, 0x18, 0x83, 0xa0, 0x2f, 0xcc, 0xf3, 0x19, 0x44, 0x73, 0x23, 0x8c, 0x98, 0x60, 0x78,
+	0x37, 0x61, 0x8c, 0x15, 0x63, 0x64, 0x8c, 0xf6, 0xc8, 0x63, 0x1e, 0x93, 0x88, 0x99, 0x8c, 0x52,
+	0x5a, 0xdb, 0xf3, 0x18, 0xf3, 0xa6, 0x60, 0xd2, 0xd0, 0x37, 0x69, 0x10, 0x30, 0x41, 0x85, 0xcf,
+	0x02, 0x9e, 0x55, 0xf7, 0x1d, 0xc6, 0xcf, 0x18, 0x37, 0xc7, 0x94, 0x43, 0x6a, 0xb2, 0xb2, 0x0c,
+	0xa9, 0xe7, 0x07, 0x12, 0xce, 0x58, 0x2d, 0x63, 0x45, 0xbc, 0x62, 0x44, 0x9c, 0xd6, 0x1a, 0x5f,
+	0xa3, 0xdd, 0x4f, 0x13, 0x75, 0xdb, 0x71, 0xd8, 0x2c, 0x10, 0x83, 0x98, 0x13, 0x38, 0x9f, 0x01,
+	0x17, 0x58, 0x45, 0x15, 0xea, 0xba, 0x11, 0x70, 0xae, 0x2a, 0xba, 0xd2, 0xac, 0x92, 0xdb, 0x29,
+	0x3e, 0x40, 0x68, 0xed, 0xa1, 0xe6, 0x75, 0xa5, 0xb9, 0xdd, 0x7a, 0xdb, 0x48, 0x4d, 0x8c, 0x24,
+	0x90, 0x91, 0xee, 0x3a, 0x33, 0x33, 0x4e, 0xa8, 0x07, 0x59, 0x57, 0x72, 0x47, 0xd9, 0x58, 0x2a,
+	0xe8, 0xc9, 0x7f, 0xcc, 0x79, 0xc8, 0x02, 0x0e, 0xf8, 0x23, 0x54, 0x10, 0x71, 0xe2, 0x5c, 0x68,
+	0x6e, 0xb7, 0xde, 0x32, 0xee, 0x7f, 0x73, 0xc6, 0x4a, 0xd8, 0x29, 0x5e, 0xfe, 0xf1, 0x2c, 0x47,
+	0x12, 0x0d, 0x3e, 0xbc, 0x27, 0xde, 0x3b, 0xff, 0x1b, 0x2f, 0xf5, 0xfd, 0x57, 0xbe, 0x5f, 0x14,
+	0x54, 0x5d, 0x39, 0xe0, 0x16, 0x2a, 0x45, 0x6c, 0x0a, 0x69, 0xa6, 0x9d, 0xd6, 0xde, 0xa6, 0x4c,
+	0x84, 0x4d, 0x81, 0xa4, 0x28, 0xfe, 0x18, 0x95, 0x23, 0xe0, 0xb3, 0xa9, 0xc8, 0x62, 0xe8, 0x9b,
+	0x44, 0x83, 0x98, 0x80, 0xc3, 0x22, 0x37, 0xdb, 0x47, 0xa6, 0xc2, 0xcf, 0x51, 0x5e, 0xc4, 0x6a,
+	0x41, 0x6a, 0x1f, 0xdf, 0x6e, 0x41, 0xc4, 0x77, 0x65, 0x79, 0x11, 0x37, 0xfe, 0x56, 0xd0, 0xd6,
+	0x6d, 0x07, 0xbc, 0x8b, 0xca, 0x13, 0xf0, 0xbd, 0x89, 0x90, 0x9f, 0xad, 0x40, 0xb2, 0x19, 0x7e,
+	0x84, 0x4a, 0x7e, 0xe0, 0x42, 0x2c, 0xa3, 0x3c, 0x20, 0xe9, 0x04, 0x63, 0x54, 0x9c, 0x50, 0x3e,
+	0x91, 0x1e, 0x55, 0x22, 0xc7, 0xc9, 0x9a, 0xf0, 0xcf, 0x40, 0x2d, 0x4a, 0xbd, 0x1c, 0x27, 0x6b,
+	0x0e, 0x73, 0x41, 0x2d, 0x49, 0xb1, 0x1c, 0xe3, 0x3d, 0x54, 0x4d, 0x9e, 0x3c, 0xa4, 0x0e, 0xa8,
+	0x65, 0xd9, 0x60, 0xbd, 0x80, 0xdf, 0x44, 0xc8, 0xa3, 0x7c, 0xf4, 0x15, 0x0d, 0x04, 0xb8, 0x6a,
+	0x45, 0xf6, 0xaa, 0x7a, 0x94, 0x7f, 0x2e, 0x17, 0xf0, 0x53, 0xb4, 0x95, 0x94, 0x67, 0x1c, 0x5c,
+	0x75, 0x4b, 0x16, 0x2b, 0x1e, 0xe5, 0x43, 0x9e, 0x96, 0x44, 0x3c, 0x1a, 0xcf, 0x05, 0x70, 0xb5,
+	0xaa, 0x2b, 0xcd, 0xd7, 0x48, 0x45, 0xc4, 0x9d, 0x64, 0xba, 0xbf, 0xc8, 0xa3, 0x62, 0xf2, 0x82,
+	0xf1, 0xbb, 0xa8, 0x46, 0x8e, 0x8f, 0xac, 0xd1, 0xb0, 0x7f, 0x7a, 0x62, 0x75, 0xed, 0x03, 0xdb,
+	0xea, 0xd5, 0x72, 0xda, 0x1b, 0x8b, 0xa5, 0xfe, 0x7a, 0x52, 0x1f, 0x06, 0x3c, 0x04, 0xc7, 0xff,
+	0xd2, 0x07, 0x17, 0x3f, 0x43, 0xdb, 0x12, 0x3d, 0xb5, 0xfa, 0x3d, 0x8b, 0xd4, 0x14, 0x6d, 0x67,
+	0xb1, 0xd4, 0x51, 0x42, 0x9d, 0x42, 0xe0, 0x42, 0x84, 0x9f, 0xa3, 0x1d, 0x09, 0x10, 0xab, 0x6b,
+	0x9f, 0xd8, 0x56, 0x7f, 0x50, 0xcb, 0x6b, 0x0f, 0x17, 0x4b, 0xfd, 0x81, 0xfc, 0x94, 0xe0, 0xf8,
+	0xa1, 0x0f, 0x81, 0x58, 0x61, 0x3d, 0xeb, 0xc8, 0x3a, 0x6c, 0x0f, 0x8e, 0x49, 0xad, 0xb0, 0xc6,
+	0x7a, 0x30, 0x05, 0x8f, 0x0a, 0x16, 0xe1, 0x7d, 0xf4, 0x50, 0x62, 0x76, 0xa7, 0x9b, 0x74, 0xb4,
+	0xec, 0xcf, 0x2c, 0x52, 0x2b, 0xae, 0xa3, 0xd9, 0x9d, 0x2e, 0x01, 0x07, 0xfc, 0x0b, 0x88, 0xf0,
+	0x4b, 0xf4, 0x24, 0x65, 0xfb, 0x03, 0x8b, 0x74, 0x3f, 0x69, 0xdb, 0xfd, 0x51, 0xbb, 0xdb, 0x3d,
+	0x1e, 0xf6, 0x07, 0xb5, 0x92, 0xf6, 0x74, 0xb1, 0xd4, 0x1f, 0x4b, 0x45, 0x20, 0x20, 0x72, 0x26,
+	0xd4, 0x0f, 0xb2, 0xbf, 0x51, 0x2b, 0x7e, 0xff, 0x53, 0x3d, 0xd7, 0xfa, 0x55, 0x41, 0x25, 0x79,
+	0x7e, 0xf0, 0xcf, 0x0a, 0x42, 0xeb, 0x43, 0x84, 0x8d, 0x4d, 0xbf, 0xd9, 0xfd, 0x47, 0x5d, 0x33,
+	0x5f, 0x99, 0x4f, 0x4f, 0x49, 0xe3, 0xe5, 0x77, 0xbf, 0xfd, 0xf5, 0x63, 0xfe, 0x7d, 0x6c, 0x98,
+	0x1b, 0xae, 0x3d, 0x9a, 0x6a, 0xb8, 0xf9, 0x4d, 0x76, 0x69, 0x7c, 0x6b, 0x8a, 0x98, 0x77, 0xac,
+	0xcb, 0xeb, 0xba, 0x72, 0x75, 0x5d, 0x57, 0xfe, 0xbc, 0xae, 0x2b, 0x3f, 0xdc, 0xd4, 0x73, 0x57,
+	0x37, 0xf5, 0xdc, 0xef, 0x37, 0xf5, 0xdc, 0x17, 0xef, 0x79, 0xbe, 0x98, 0xcc, 0xc6, 0x86, 0xc3,
+	0xce, 0xcc, 0xec, 0xba, 0x92, 0xad, 0x2f, 0x3e, 0x34, 0xe3, 0x3b, 0xfd, 0xc5, 0x3c, 0x04, 0x3e,
+	0x2e, 0xcb, 0xbb, 0xeb, 0x83, 0x7f, 0x06, 0x00, 0x2b, 0x85, 0x8b, 0x19, 0x75, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// AccountTxs returns the txs involving an account, in the order they were
+	// executed.
+	AccountTxs(ctx context.Context, in *QueryAccountTxsRequest, opts ...grpc.CallOption) (*QueryAccountTxsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AccountTxs(ctx context.Context, in *QueryAccountTxsRequest, opts ...grpc.CallOption) (*QueryAccountTxsResponse, error) {
+	out := new(QueryAccountTxsResponse)
+	err := c.cc.Invoke(ctx, "/gaia.txhistory.v1beta1.Query/AccountTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// AccountTxs returns the txs involving an account, in the order they were
+	// executed.
+	AccountTxs(context.Context, *QueryAccountTxsRequest) (*QueryAccountTxsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AccountTxs(ctx context.Context, req *QueryAccountTxsRequest) (*QueryAccountTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountTxs not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AccountTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.txhistory.v1beta1.Query/AccountTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountTxs(ctx, req.(*QueryAccountTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.txhistory.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AccountTxs",
+			Handler:    _Query_AccountTxs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/txhistory/v1beta1/query.proto",
+}
+
+func (m *QueryAccountTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Roles) > 0 {
+		dAtA6 := make([]byte, len(m.Roles)*10)
+		var j5 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintQuery(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Time != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TxRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovQuery(uint64(m.Time))
+	}
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovQuery(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, AccountTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v Role
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Role(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]Role, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Role
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Role(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &tx.Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)